@@ -1,10 +1,10 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"log"
 	"os"
-	"time"
 
 	"goderby/internal/data"
 	"goderby/internal/models"
@@ -30,9 +30,18 @@ type AppModel struct {
 	spa                ui.SpaModel
 	summary            *ui.SummaryModel
 	info               ui.InfoModel
+	breeding           ui.BreedingModel
+	stable             ui.StableModel
+	economy            ui.EconomyModel
+	homes              ui.HomesModel
+	event              ui.EventModel
+	skills             ui.SkillsModel
+	planner            ui.PlannerModel
+	shop               ui.ShopModel
+	sponsors           ui.SponsorsModel
+	jockeys            ui.JockeysModel
 
 	// Data
-	availableHorses     []models.Horse
 	availableRaces      []models.Race
 	availableSupporters []models.Supporter
 
@@ -41,8 +50,8 @@ type AppModel struct {
 	quitting    bool
 }
 
-func NewAppModel() *AppModel {
-	dataLoader := data.NewDataLoader("")
+func NewAppModel(assetsPath string) *AppModel {
+	dataLoader := data.NewDataLoader(assetsPath)
 	gameState := models.NewGameState()
 
 	return &AppModel{
@@ -55,12 +64,7 @@ func NewAppModel() *AppModel {
 }
 
 func (m *AppModel) Init() tea.Cmd {
-	return tea.Batch(
-		func() tea.Msg { return InitDataMsg{} },
-		tea.Tick(time.Second*5, func(t time.Time) tea.Msg {
-			return PassiveIncomeUpdateMsg{}
-		}),
-	)
+	return func() tea.Msg { return InitDataMsg{} }
 }
 
 func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -76,29 +80,29 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case InitDataMsg:
 		return m.initializeData()
 
-	case PassiveIncomeUpdateMsg:
-		return m.updatePassiveIncome()
-
 	case ui.NavigationMsg:
 		return m.handleNavigation(msg)
 
 	case ui.MenuSelectionMsg:
 		return m.handleMenuSelection(msg)
 
-	case ui.HorseSelectedMsg:
-		m.gameState.PlayerHorse = &msg.Horse
+	case ui.SupportersSelectedMsg:
 		m.mainMenu = ui.NewMainMenuModel(m.gameState, GameVersion)
 		m.currentView = ui.MainMenuView
 		return m, nil
 
-	case ui.SupportersSelectedMsg:
-		m.mainMenu = ui.NewMainMenuModel(m.gameState, GameVersion)
-		m.currentView = ui.MainMenuView
+	case ui.PlanRaceMsg:
+		m.planner = ui.NewPlannerModel(m.gameState, m.availableRaces, msg.RaceID)
+		m.currentView = ui.PlannerView
 		return m, nil
 
 	case ui.WeekCompleteMsg:
-		m.gameState.Season.NextWeek()
+		m.gameState.CompleteWeek()
 		m.train = ui.NewTrainModel(m.gameState)
+		if m.gameState.PendingEvent != nil {
+			m.currentView = ui.EventView
+			m.event = ui.NewEventModel(m.gameState, ui.TrainView)
+		}
 		return m, nil
 
 	case tea.QuitMsg:
@@ -143,6 +147,46 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		var model tea.Model
 		model, cmd = m.info.Update(msg)
 		m.info = model.(ui.InfoModel)
+	case ui.BreedingView:
+		var model tea.Model
+		model, cmd = m.breeding.Update(msg)
+		m.breeding = model.(ui.BreedingModel)
+	case ui.StableView:
+		var model tea.Model
+		model, cmd = m.stable.Update(msg)
+		m.stable = model.(ui.StableModel)
+	case ui.EconomyView:
+		var model tea.Model
+		model, cmd = m.economy.Update(msg)
+		m.economy = model.(ui.EconomyModel)
+	case ui.HomesView:
+		var model tea.Model
+		model, cmd = m.homes.Update(msg)
+		m.homes = model.(ui.HomesModel)
+	case ui.EventView:
+		var model tea.Model
+		model, cmd = m.event.Update(msg)
+		m.event = model.(ui.EventModel)
+	case ui.SkillsView:
+		var model tea.Model
+		model, cmd = m.skills.Update(msg)
+		m.skills = model.(ui.SkillsModel)
+	case ui.PlannerView:
+		var model tea.Model
+		model, cmd = m.planner.Update(msg)
+		m.planner = model.(ui.PlannerModel)
+	case ui.ShopView:
+		var model tea.Model
+		model, cmd = m.shop.Update(msg)
+		m.shop = model.(ui.ShopModel)
+	case ui.SponsorsView:
+		var model tea.Model
+		model, cmd = m.sponsors.Update(msg)
+		m.sponsors = model.(ui.SponsorsModel)
+	case ui.JockeysView:
+		var model tea.Model
+		model, cmd = m.jockeys.Update(msg)
+		m.jockeys = model.(ui.JockeysModel)
 	}
 
 	return m, cmd
@@ -176,6 +220,26 @@ func (m *AppModel) View() string {
 		return m.summary.View()
 	case ui.InfoView:
 		return m.info.View()
+	case ui.BreedingView:
+		return m.breeding.View()
+	case ui.StableView:
+		return m.stable.View()
+	case ui.EconomyView:
+		return m.economy.View()
+	case ui.HomesView:
+		return m.homes.View()
+	case ui.EventView:
+		return m.event.View()
+	case ui.SkillsView:
+		return m.skills.View()
+	case ui.PlannerView:
+		return m.planner.View()
+	case ui.ShopView:
+		return m.shop.View()
+	case ui.SponsorsView:
+		return m.sponsors.View()
+	case ui.JockeysView:
+		return m.jockeys.View()
 	default:
 		return m.mainMenu.View()
 	}
@@ -196,7 +260,6 @@ func (m *AppModel) initializeData() (*AppModel, tea.Cmd) {
 		log.Printf("Failed to load horses: %v", err)
 		horses = []models.Horse{}
 	}
-	m.availableHorses = horses
 	m.gameState.AvailableHorses = horses
 
 	// Load supporters
@@ -217,9 +280,33 @@ func (m *AppModel) initializeData() (*AppModel, tea.Cmd) {
 	m.availableRaces = races
 	m.gameState.AvailableRaces = races
 
+	// Load event definitions
+	events, err := m.dataLoader.LoadEvents()
+	if err != nil {
+		log.Printf("Failed to load events: %v", err)
+		events = []models.EventDefinition{}
+	}
+	m.gameState.EventLibrary = models.NewEventLibrary(events)
+
+	// Load supporter profiles
+	profiles, err := m.dataLoader.LoadSupporterProfiles()
+	if err != nil {
+		log.Printf("Failed to load supporter profiles: %v", err)
+		profiles = models.ProfileLibrary{}
+	}
+	m.gameState.SupporterProfiles = profiles
+
+	// Load race commentary packs
+	commentary, err := m.dataLoader.LoadCommentary()
+	if err != nil {
+		log.Printf("Failed to load commentary: %v", err)
+		commentary = models.CommentaryLibrary{}
+	}
+	m.gameState.Commentary = commentary
+
 	// Initialize view models
 	m.mainMenu = ui.NewMainMenuModel(m.gameState, GameVersion)
-	m.scout = ui.NewScoutModel(m.gameState, m.availableHorses)
+	m.scout = ui.NewScoutModel(m.gameState, m.gameState.AvailableHorses)
 	m.supporterSelection = ui.NewSupporterSelectionModel(m.gameState)
 	m.train = ui.NewTrainModel(m.gameState)
 	m.race = ui.NewRaceModel(m.gameState, m.availableRaces)
@@ -227,6 +314,18 @@ func (m *AppModel) initializeData() (*AppModel, tea.Cmd) {
 	m.spa = ui.NewSpaModel(m.gameState)
 	m.summary = ui.NewSummaryModel(m.gameState)
 	m.info = ui.NewInfoModel(GameVersion)
+	m.breeding = ui.NewBreedingModel(m.gameState)
+	m.stable = ui.NewStableModel(m.gameState)
+	m.economy = ui.NewEconomyModel(m.gameState)
+	m.homes = ui.NewHomesModel(m.gameState)
+	m.event = ui.NewEventModel(m.gameState, ui.MainMenuView)
+	m.skills = ui.NewSkillsModel(m.gameState)
+	m.shop = ui.NewShopModel(m.gameState)
+	m.sponsors = ui.NewSponsorsModel(m.gameState)
+	m.jockeys = ui.NewJockeysModel(m.gameState)
+	if m.gameState.PendingEvent != nil {
+		m.currentView = ui.EventView
+	}
 
 	m.initialized = true
 
@@ -239,9 +338,15 @@ func (m *AppModel) handleNavigation(msg ui.NavigationMsg) (*AppModel, tea.Cmd) {
 	// Refresh models when switching views
 	switch msg.State {
 	case ui.MainMenuView:
+		// A season event left undecided (e.g. from a saved game) must be settled first
+		if m.gameState.PendingEvent != nil {
+			m.currentView = ui.EventView
+			m.event = ui.NewEventModel(m.gameState, ui.MainMenuView)
+			return m, nil
+		}
 		m.mainMenu = ui.NewMainMenuModel(m.gameState, GameVersion)
 	case ui.ScoutView:
-		m.scout = ui.NewScoutModel(m.gameState, m.availableHorses)
+		m.scout = ui.NewScoutModel(m.gameState, m.gameState.AvailableHorses)
 	case ui.SupporterSelectionView:
 		m.supporterSelection = ui.NewSupporterSelectionModel(m.gameState)
 	case ui.TrainView:
@@ -250,10 +355,30 @@ func (m *AppModel) handleNavigation(msg ui.NavigationMsg) (*AppModel, tea.Cmd) {
 		m.race = ui.NewRaceModel(m.gameState, m.availableRaces)
 	case ui.SupportersView:
 		m.supporters = ui.NewSupportersModel(m.gameState)
+	case ui.SpaView:
+		m.spa = ui.NewSpaModel(m.gameState)
 	case ui.SummaryView:
 		m.summary = ui.NewSummaryModel(m.gameState)
 	case ui.InfoView:
 		m.info = ui.NewInfoModel(GameVersion)
+	case ui.BreedingView:
+		m.breeding = ui.NewBreedingModel(m.gameState)
+	case ui.StableView:
+		m.stable = ui.NewStableModel(m.gameState)
+	case ui.EconomyView:
+		m.economy = ui.NewEconomyModel(m.gameState)
+	case ui.HomesView:
+		m.homes = ui.NewHomesModel(m.gameState)
+	case ui.EventView:
+		m.event = ui.NewEventModel(m.gameState, ui.MainMenuView)
+	case ui.SkillsView:
+		m.skills = ui.NewSkillsModel(m.gameState)
+	case ui.ShopView:
+		m.shop = ui.NewShopModel(m.gameState)
+	case ui.SponsorsView:
+		m.sponsors = ui.NewSponsorsModel(m.gameState)
+	case ui.JockeysView:
+		m.jockeys = ui.NewJockeysModel(m.gameState)
 	}
 
 	return m, nil
@@ -263,7 +388,10 @@ func (m *AppModel) handleMenuSelection(msg ui.MenuSelectionMsg) (*AppModel, tea.
 	switch msg.Choice {
 	case "Scout Horse":
 		m.currentView = ui.ScoutView
-		m.scout = ui.NewScoutModel(m.gameState, m.availableHorses)
+		m.scout = ui.NewScoutModel(m.gameState, m.gameState.AvailableHorses)
+	case "Stable":
+		m.currentView = ui.StableView
+		m.stable = ui.NewStableModel(m.gameState)
 	case "Train":
 		m.currentView = ui.TrainView
 		m.train = ui.NewTrainModel(m.gameState)
@@ -273,12 +401,30 @@ func (m *AppModel) handleMenuSelection(msg ui.MenuSelectionMsg) (*AppModel, tea.
 	case "Supporters":
 		m.currentView = ui.SupportersView
 		m.supporters = ui.NewSupportersModel(m.gameState)
+	case "Supporter Shop":
+		m.currentView = ui.ShopView
+		m.shop = ui.NewShopModel(m.gameState)
 	case "Horse Spa":
 		m.currentView = ui.SpaView
 		m.spa = ui.NewSpaModel(m.gameState)
+	case "Jockeys":
+		m.currentView = ui.JockeysView
+		m.jockeys = ui.NewJockeysModel(m.gameState)
+	case "Sponsors":
+		m.currentView = ui.SponsorsView
+		m.sponsors = ui.NewSponsorsModel(m.gameState)
+	case "Finances":
+		m.currentView = ui.EconomyView
+		m.economy = ui.NewEconomyModel(m.gameState)
+	case "Retirement Homes":
+		m.currentView = ui.HomesView
+		m.homes = ui.NewHomesModel(m.gameState)
 	case "Season Summary":
 		m.currentView = ui.SummaryView
 		m.summary = ui.NewSummaryModel(m.gameState)
+	case "Breeding":
+		m.currentView = ui.BreedingView
+		m.breeding = ui.NewBreedingModel(m.gameState)
 	case "Save & Quit":
 		return m.handleQuit()
 	}
@@ -296,27 +442,13 @@ func (m *AppModel) handleQuit() (*AppModel, tea.Cmd) {
 	return m, tea.Quit
 }
 
-func (m *AppModel) updatePassiveIncome() (*AppModel, tea.Cmd) {
-	// Update passive income from retired horses
-	m.gameState.UpdatePassiveGains()
-
-	// Save the updated game state
-	if err := m.dataLoader.SaveGameState(m.gameState); err != nil {
-		log.Printf("Failed to save game state after passive income update: %v", err)
-	}
-
-	// Schedule next passive income update in 5 minutes
-	return m, tea.Tick(time.Second*300, func(t time.Time) tea.Msg {
-		return PassiveIncomeUpdateMsg{}
-	})
-}
-
 type InitDataMsg struct{}
 
-type PassiveIncomeUpdateMsg struct{}
-
 func main() {
-	app := NewAppModel()
+	assetsPath := flag.String("assets", "assets", "directory of custom events/, supporters/ and commentary/ packs")
+	flag.Parse()
+
+	app := NewAppModel(*assetsPath)
 	p := tea.NewProgram(app, tea.WithAltScreen())
 
 	if _, err := p.Run(); err != nil {
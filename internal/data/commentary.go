@@ -0,0 +1,47 @@
+package data
+
+import (
+	"embed"
+	"encoding/json"
+	"log"
+
+	"goderby/internal/models"
+)
+
+//go:embed commentary/*.json
+var defaultCommentary embed.FS
+
+// LoadCommentary loads the built-in commentary packs, then any from AssetsPath/commentary.
+// Packs in the assets directory replace built-in ones with the same ID.
+func (dl *DataLoader) LoadCommentary() (models.CommentaryLibrary, error) {
+	packs, err := loadPacks(dl, packSource[models.CommentaryPack]{
+		builtin: defaultCommentary,
+		dir:     "commentary",
+		kind:    "commentary",
+		decode:  decodeCommentary,
+		id:      func(pack models.CommentaryPack) string { return pack.ID },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	library := make(models.CommentaryLibrary, len(packs))
+	for _, pack := range packs {
+		if err := pack.Validate(); err != nil {
+			log.Printf("Skipping invalid commentary pack: %v", err)
+			continue
+		}
+		library[pack.ID] = pack
+	}
+
+	return library, nil
+}
+
+// decodeCommentary parses a commentary file, which holds a single pack
+func decodeCommentary(data []byte) ([]models.CommentaryPack, error) {
+	var pack models.CommentaryPack
+	if err := json.Unmarshal(data, &pack); err != nil {
+		return nil, err
+	}
+	return []models.CommentaryPack{pack}, nil
+}
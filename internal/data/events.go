@@ -0,0 +1,48 @@
+package data
+
+import (
+	"embed"
+	"encoding/json"
+	"log"
+
+	"goderby/internal/models"
+)
+
+//go:embed events/*.json
+var defaultEvents embed.FS
+
+// LoadEvents loads the built-in event definitions, then any from AssetsPath/events.
+// Definitions in the assets directory replace built-in ones with the same ID.
+func (dl *DataLoader) LoadEvents() ([]models.EventDefinition, error) {
+	definitions, err := loadPacks(dl, packSource[models.EventDefinition]{
+		builtin: defaultEvents,
+		dir:     "events",
+		kind:    "event",
+		decode:  decodeEvents,
+		id:      func(def models.EventDefinition) string { return def.ID },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// A bad definition is left out rather than costing the game every event
+	valid := definitions[:0]
+	for _, def := range definitions {
+		if err := def.Validate(); err != nil {
+			log.Printf("Skipping invalid event definition: %v", err)
+			continue
+		}
+		valid = append(valid, def)
+	}
+
+	return valid, nil
+}
+
+// decodeEvents parses an event file, which holds a list of definitions
+func decodeEvents(data []byte) ([]models.EventDefinition, error) {
+	var definitions []models.EventDefinition
+	if err := json.Unmarshal(data, &definitions); err != nil {
+		return nil, err
+	}
+	return definitions, nil
+}
@@ -40,7 +40,7 @@ func (dl *DataLoader) LoadSupporters(gameState *models.GameState) ([]models.Supp
 func (dl *DataLoader) LoadRaces(gameState *models.GameState) ([]models.Race, error) {
 	// If game state has saved races, use them
 	if len(gameState.AvailableRaces) > 0 {
-		return gameState.AvailableRaces, nil
+		return mergeNewRaces(gameState.AvailableRaces, dl.generateDefaultRaces()), nil
 	}
 	// Otherwise generate new races for new game
 	return dl.generateDefaultRaces(), nil
@@ -77,6 +77,10 @@ func (dl *DataLoader) LoadGameState() (*models.GameState, error) {
 	if err := json.Unmarshal(data, &gameState); err != nil {
 		return nil, fmt.Errorf("failed to unmarshal game state: %w", err)
 	}
+	gameState.RelinkStable()
+	gameState.MigrateTreasury()
+	gameState.SyncRetirementHomes()
+	gameState.BackfillG1Wins()
 
 	return &gameState, nil
 }
@@ -108,6 +112,13 @@ func (dl *DataLoader) generateDefaultHorses() []models.Horse {
 		}
 
 		horse := models.NewHorse(name, breeds[i%len(breeds)], baseStats)
+		horse.Sex = models.Sex(i % 2)
+		horse.Aptitudes = models.Aptitudes{
+			Sprint: 30 + rand.Intn(61),
+			Mile:   30 + rand.Intn(61),
+			Medium: 30 + rand.Intn(61),
+			Long:   30 + rand.Intn(61),
+		}
 		horses = append(horses, *horse)
 	}
 
@@ -292,8 +303,31 @@ func (dl *DataLoader) generateDefaultRaces() []models.Race {
 		models.NewRace("Autumn Championship", 2000, models.Grade1, 50000, 180),
 		models.NewRace("Winter Cup", 1800, models.Grade1, 75000, 200),
 		models.NewRace("Grand Prix", 2500, models.GradeG1, 100000, 220),
+		models.NewRace("Fan Favourite Invitational", 2000, models.Grade2, 40000, 140),
+		models.NewRace("Legends Invitational", 2400, models.Grade1, 120000, 190),
+		models.NewRace("City Handicap", 1800, models.Grade3, 20000, 130),
+		models.NewRace("Juvenile Allowance", 1400, models.MaidenRace, 8000, 0),
+		models.NewRace("Autumn Handicap", 2200, models.Grade2, 40000, 160),
 	}
 
+	// Invitationals are open only to horses with a big enough following
+	races[6].MinFans = models.FanTiers[3].MinFans
+	races[7].MinFans = models.FanTiers[4].MinFans
+
+	// Handicaps weigh the field by rating and form; allowances favour young horses and mares
+	races[8].Weighting = models.Handicap
+	races[9].Weighting = models.Allowance
+	races[10].Weighting = models.Handicap
+
+	// Course layouts decide how much the gate draw matters
+	races[1].Course = models.TightOval
+	races[2].Course = models.Galloping
+	races[4].Course = models.TightOval
+	races[5].Course = models.Galloping
+	races[7].Course = models.Galloping
+	races[8].Course = models.TightOval
+	races[9].Course = models.Straight
+
 	result := make([]models.Race, len(races))
 	for i, race := range races {
 		result[i] = *race
@@ -301,3 +335,24 @@ func (dl *DataLoader) generateDefaultRaces() []models.Race {
 
 	return result
 }
+
+// mergeNewRaces adds default races missing from a saved race list and refreshes course
+// layouts, so older saves pick up new races and courses
+func mergeNewRaces(saved, defaults []models.Race) []models.Race {
+	courses := make(map[string]models.Course, len(defaults))
+	for _, race := range defaults {
+		courses[race.Name] = race.Course
+	}
+
+	names := make(map[string]bool, len(saved))
+	for i, race := range saved {
+		names[race.Name] = true
+		saved[i].Course = courses[race.Name]
+	}
+	for _, race := range defaults {
+		if !names[race.Name] {
+			saved = append(saved, race)
+		}
+	}
+	return saved
+}
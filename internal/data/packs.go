@@ -0,0 +1,85 @@
+package data
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// packSource describes a directory of JSON packs: the built-in copies embedded in the
+// binary and the name of the directory under AssetsPath that can override them
+type packSource[T any] struct {
+	builtin fs.FS
+	dir     string
+	kind    string                         // What the files hold, for error messages
+	decode  func(data []byte) ([]T, error) // Parses one file into its packs
+	id      func(pack T) string
+}
+
+// loadPacks loads the built-in packs, then any from AssetsPath/dir, in file order.
+// Packs in the assets directory replace built-in ones with the same ID in place.
+func loadPacks[T any](dl *DataLoader, src packSource[T]) ([]T, error) {
+	packs, err := readPackFiles(src, src.builtin, src.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	if dl.AssetsPath != "" {
+		dir := filepath.Join(dl.AssetsPath, src.dir)
+		if _, err := os.Stat(dir); err == nil {
+			custom, err := readPackFiles(src, os.DirFS(dir), ".")
+			if err != nil {
+				return nil, err
+			}
+			packs = mergePacks(src, packs, custom)
+		}
+	}
+
+	return packs, nil
+}
+
+// mergePacks adds custom packs, replacing any with a matching ID
+func mergePacks[T any](src packSource[T], base, custom []T) []T {
+	index := make(map[string]int, len(base))
+	for i, pack := range base {
+		index[src.id(pack)] = i
+	}
+
+	for _, pack := range custom {
+		if i, ok := index[src.id(pack)]; ok {
+			base[i] = pack
+			continue
+		}
+		index[src.id(pack)] = len(base)
+		base = append(base, pack)
+	}
+
+	return base
+}
+
+// readPackFiles reads every JSON pack file in a directory, in name order
+func readPackFiles[T any](src packSource[T], fsys fs.FS, dir string) ([]T, error) {
+	paths, err := fs.Glob(fsys, filepath.ToSlash(filepath.Join(dir, "*.json")))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s files: %w", src.kind, err)
+	}
+	sort.Strings(paths)
+
+	var packs []T
+	for _, path := range paths {
+		data, err := fs.ReadFile(fsys, path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s file %s: %w", src.kind, path, err)
+		}
+
+		filePacks, err := src.decode(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s file %s: %w", src.kind, path, err)
+		}
+		packs = append(packs, filePacks...)
+	}
+
+	return packs, nil
+}
@@ -0,0 +1,47 @@
+package data
+
+import (
+	"embed"
+	"encoding/json"
+	"log"
+
+	"goderby/internal/models"
+)
+
+//go:embed supporters/*.json
+var defaultProfiles embed.FS
+
+// LoadSupporterProfiles loads the built-in supporter profiles, then any from AssetsPath/supporters.
+// Profiles in the assets directory replace built-in ones for the same supporter.
+func (dl *DataLoader) LoadSupporterProfiles() (models.ProfileLibrary, error) {
+	profiles, err := loadPacks(dl, packSource[models.SupporterProfile]{
+		builtin: defaultProfiles,
+		dir:     "supporters",
+		kind:    "supporter",
+		decode:  decodeProfiles,
+		id:      func(profile models.SupporterProfile) string { return profile.ID },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	library := make(models.ProfileLibrary, len(profiles))
+	for _, profile := range profiles {
+		if err := profile.Validate(); err != nil {
+			log.Printf("Skipping invalid supporter profile: %v", err)
+			continue
+		}
+		library[profile.ID] = profile
+	}
+
+	return library, nil
+}
+
+// decodeProfiles parses a supporter file, which holds a list of profiles
+func decodeProfiles(data []byte) ([]models.SupporterProfile, error) {
+	var profiles []models.SupporterProfile
+	if err := json.Unmarshal(data, &profiles); err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
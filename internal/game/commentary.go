@@ -0,0 +1,137 @@
+package game
+
+import (
+	"math/rand"
+	"strconv"
+
+	"goderby/internal/models"
+)
+
+const (
+	// moveGain is how many places a horse must make up in a turn to be called as making a move
+	moveGain = 3
+	// surgeGain is the same for the player's horse, which the commentator watches more closely
+	surgeGain = 2
+	// fadingEnergy is the share of its aerobic reserve left when a tiring horse is called as fading
+	fadingEnergy = 0.2
+	// colourChance is the chance of a line about the shape of the race on an otherwise quiet turn
+	colourChance = 0.3
+)
+
+// narrator watches the race turn by turn and calls the most interesting thing happening
+type narrator struct {
+	commentator *models.Commentator
+	horses      map[string]*models.Horse
+	player      string
+	faded       map[string]bool // Horses already called as fading
+	lastColour  int             // Turn of the last line about the shape of the race
+}
+
+func newNarrator(commentator *models.Commentator, horses map[string]*models.Horse, player string) *narrator {
+	return &narrator{
+		commentator: commentator,
+		horses:      horses,
+		player:      player,
+		faded:       make(map[string]bool),
+	}
+}
+
+// call returns the line for this turn: the finish, then the race's milestones, then the
+// player's horse surging, lead changes, moves, fading horses and the shape of the field
+func (n *narrator) call(turn, totalTurns int, before, positions, distances map[string]int, energy models.EnergyMap) string {
+	leader := horseAt(positions, 1)
+	second := horseAt(positions, 2)
+	previousLeader := horseAt(before, 1)
+
+	switch turn {
+	case totalTurns:
+		if isClose(distances[leader], distances[second]) {
+			return n.say("close_finish", leader, second)
+		}
+		return n.say("winner", leader, "")
+	case 1:
+		return n.say("off", leader, "")
+	case totalTurns / 4:
+		return n.say("early_leader", leader, "")
+	case totalTurns / 2:
+		return n.say("halfway", leader, "")
+	case (totalTurns * 3) / 4:
+		return n.say("home_stretch", leader, "")
+	}
+
+	if gained := before[n.player] - positions[n.player]; gained >= surgeGain {
+		return n.say("player_surge", n.player, "", "gained", strconv.Itoa(gained))
+	}
+
+	if leader != previousLeader {
+		return n.say("lead_change", leader, previousLeader)
+	}
+
+	mover, bestGain := "", 0
+	for horseID, position := range positions {
+		if gained := before[horseID] - position; gained >= moveGain && gained > bestGain {
+			mover, bestGain = horseID, gained
+		}
+	}
+	if mover != "" {
+		return n.say("move", mover, "", "gained", strconv.Itoa(bestGain))
+	}
+
+	for horseID, position := range positions {
+		if n.faded[horseID] || position <= before[horseID] {
+			continue
+		}
+		if energy[horseID].AerobicRatio() <= fadingEnergy {
+			n.faded[horseID] = true
+			return n.say("fading", horseID, "")
+		}
+	}
+
+	if turn-n.lastColour < 3 || rand.Float64() >= colourChance {
+		return ""
+	}
+	n.lastColour = turn
+	if fourth := horseAt(positions, 4); fourth != "" && (distances[leader]-distances[fourth])*50 <= distances[leader] {
+		return n.say("bunched", leader, "")
+	}
+	if turn > totalTurns/2 && distances[leader]-distances[second] > distances[leader]/20 {
+		return n.say("clear", leader, second)
+	}
+	return ""
+}
+
+// say fills in a cue with the horses' names and any extra name/value pairs
+func (n *narrator) say(cue, horseID, otherID string, vars ...string) string {
+	vars = append(vars, "horse", n.name(horseID), "other", n.name(otherID))
+	return n.commentator.Say(cue, vars...)
+}
+
+func (n *narrator) name(horseID string) string {
+	if horse, ok := n.horses[horseID]; ok {
+		return horse.Name
+	}
+	return ""
+}
+
+// horseAt returns the horse in the given position
+func horseAt(positions map[string]int, position int) string {
+	for horseID, pos := range positions {
+		if pos == position {
+			return horseID
+		}
+	}
+	return ""
+}
+
+// isClose reports whether two distances are within 1% of the first
+func isClose(first, second int) bool {
+	return first > 0 && (first-second)*100 <= first
+}
+
+// appendLine adds a commentary line, skipping cues the pack has no lines for
+func appendLine(lines []string, line string) []string {
+	if line == "" {
+		return lines
+	}
+	return append(lines, line)
+}
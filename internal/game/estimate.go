@@ -0,0 +1,66 @@
+package game
+
+import (
+	"math"
+
+	"goderby/internal/models"
+)
+
+// randomFactorVariance is the variance of the 0.8-1.2 movement roll applied each turn
+const randomFactorVariance = 0.4 * 0.4 / 12
+
+// ExpectedDistance returns the mean and variance of the distance a horse covers in a race
+// run at an even cruising pace in clear air, ignoring skills and interactive controls
+func ExpectedDistance(race models.Race, horse *models.Horse) (float64, float64) {
+	race.Weights = models.WeightMap{horse.ID: race.WeightFor(horse)}
+	rs := NewRaceSimulator(race, map[string]*models.Horse{horse.ID: horse}, horse.ID, models.RaceStrategy{})
+
+	numTurns := max(race.Distance/100, 10)
+	energy := models.NewEnergy(horse)
+	mean, variance := 0.0, 0.0
+	for turn := 1; turn <= numTurns; turn++ {
+		effort := energy.Run(models.CruiseEffort, false) / models.CruiseEffort
+		speed := float64(rs.calculateHorseSpeed(horse, turn, numTurns)) * effort
+		mean += speed
+		variance += speed * speed * randomFactorVariance
+	}
+	return mean, variance
+}
+
+// WinMargin returns how many standard deviations the horse's expected distance is ahead of
+// its strongest rival's, negative when it is behind. Unlike the win chance it keeps
+// separating horses far ahead of or behind the field.
+func WinMargin(race models.Race, horse *models.Horse, rivals []*models.Horse) float64 {
+	mean, variance := ExpectedDistance(race, horse)
+
+	margin := math.Inf(1)
+	for _, rival := range rivals {
+		rivalMean, rivalVariance := ExpectedDistance(race, rival)
+		spread := math.Max(math.Sqrt(variance+rivalVariance), 1)
+		margin = math.Min(margin, (mean-rivalMean)/spread)
+	}
+	if math.IsInf(margin, 1) {
+		return mean
+	}
+	return margin
+}
+
+// EstimateWinChance approximates the chance a horse finishes ahead of every rival,
+// treating each head-to-head as an independent normal comparison
+func EstimateWinChance(race models.Race, horse *models.Horse, rivals []*models.Horse) float64 {
+	mean, variance := ExpectedDistance(race, horse)
+
+	chance := 1.0
+	for _, rival := range rivals {
+		rivalMean, rivalVariance := ExpectedDistance(race, rival)
+		spread := math.Sqrt(variance + rivalVariance)
+		if spread == 0 {
+			if mean <= rivalMean {
+				return 0
+			}
+			continue
+		}
+		chance *= 0.5 * (1 + math.Erf((mean-rivalMean)/(spread*math.Sqrt2)))
+	}
+	return chance
+}
@@ -0,0 +1,136 @@
+package game
+
+import (
+	"math/rand"
+
+	"goderby/internal/models"
+)
+
+// pressEnd is the share of the race a leader spends pressing for the front
+const pressEnd = 0.3
+
+// fieldStrategies returns the strategy each horse is ridden to: the player's choice for
+// their horse, and a random one for each rival
+func (rs *RaceSimulator) fieldStrategies() map[string]models.RaceStrategy {
+	strategies := make(map[string]models.RaceStrategy, len(rs.race.Entrants))
+	for _, horseID := range rs.race.Entrants {
+		if horseID == rs.playerHorse {
+			strategies[horseID] = rs.strategy
+			continue
+		}
+		strategies[horseID] = models.RaceStrategy{
+			Formation: models.Formation(rand.Intn(3)),
+			Pace:      models.Pace(rand.Intn(3)),
+		}
+	}
+	return strategies
+}
+
+// cruisingEffort is how hard each pace asks a horse to run before its kick
+func cruisingEffort(pace models.Pace) float64 {
+	switch pace {
+	case models.Fast:
+		return 0.95
+	case models.Conserve:
+		return 0.8
+	default:
+		return models.CruiseEffort
+	}
+}
+
+// kickPoint is how far into the race each formation starts its finishing kick
+func kickPoint(formation models.Formation) float64 {
+	switch formation {
+	case models.Draft:
+		return 0.75
+	case models.Mount:
+		return 0.7
+	default:
+		return 0.8
+	}
+}
+
+// pacingEffort decides how hard a horse is asked to run this turn. Leaders press for the
+// front early, drafters close up on the horse ahead and then sit in, and hold-up horses
+// settle at the back; all of them kick for home once the finish is near.
+func pacingEffort(strategy models.RaceStrategy, progress float64, position int, drafting bool) float64 {
+	if progress >= kickPoint(strategy.Formation) {
+		return models.KickEffort
+	}
+
+	effort := cruisingEffort(strategy.Pace)
+	switch strategy.Formation {
+	case models.Lead:
+		if progress < pressEnd && position > 1 {
+			effort += 0.1
+		}
+	case models.Draft:
+		if !drafting && position > 1 {
+			effort += 0.05
+		}
+	case models.Mount:
+		effort -= 0.04
+	}
+	return effort
+}
+
+// draftingHorses returns the horses sitting close enough behind another to draft off it
+func draftingHorses(distances map[string]int) map[string]bool {
+	leader := 0
+	for _, distance := range distances {
+		leader = max(leader, distance)
+	}
+	gap := int(float64(leader) * models.DraftGap)
+
+	drafting := make(map[string]bool, len(distances))
+	for horseID, distance := range distances {
+		for otherID, other := range distances {
+			if otherID != horseID && other > distance && other-distance <= gap {
+				drafting[horseID] = true
+				break
+			}
+		}
+	}
+	return drafting
+}
+
+// runTurn spends a horse's energy for the turn at the effort its strategy asks for and
+// returns the share of its cruising speed it manages
+func runTurn(energy models.EnergyMap, horseID string, strategy models.RaceStrategy, progress float64, position int, drafting bool) float64 {
+	reserves := energy[horseID]
+	effort := reserves.Run(pacingEffort(strategy, progress, position, drafting), drafting)
+	energy[horseID] = reserves
+	return effort / models.CruiseEffort
+}
+
+// SpendWhip charges the whip to a horse's sprint reserve in a simulated race from the given
+// turn on, reporting whether the horse had the energy to answer it
+func SpendWhip(progress []models.RaceProgressUpdate, turn int, horseID string) bool {
+	if turn >= len(progress) {
+		return false
+	}
+	reserves := progress[turn].Energy[horseID]
+	if !reserves.Spend(models.WhipCost) {
+		return false
+	}
+	progress[turn].Energy[horseID] = reserves
+
+	for later := turn + 1; later < len(progress); later++ {
+		reserves, ok := progress[later].Energy[horseID]
+		if !ok {
+			continue
+		}
+		reserves.Sprint = max(reserves.Sprint-models.WhipCost, 0)
+		progress[later].Energy[horseID] = reserves
+	}
+	return true
+}
+
+// energySnapshot copies the field's reserves for a progress update
+func energySnapshot(energy models.EnergyMap) models.EnergyMap {
+	snapshot := make(models.EnergyMap, len(energy))
+	for horseID, reserves := range energy {
+		snapshot[horseID] = reserves
+	}
+	return snapshot
+}
@@ -0,0 +1,47 @@
+package game
+
+import (
+	"testing"
+
+	"goderby/internal/models"
+)
+
+func TestSpendWhip(t *testing.T) {
+	progress := func(sprints ...int) []models.RaceProgressUpdate {
+		updates := make([]models.RaceProgressUpdate, len(sprints))
+		for i, sprint := range sprints {
+			updates[i] = models.RaceProgressUpdate{
+				Turn:   i,
+				Energy: models.EnergyMap{"player": {Aerobic: 100, Sprint: sprint, MaxAerobic: 300, MaxSprint: 60}},
+			}
+		}
+		return updates
+	}
+
+	tests := []struct {
+		name        string
+		sprints     []int
+		turn        int
+		wantOK      bool
+		wantSprints []int
+	}{
+		{"charges the turn and every later one", []int{60, 50, 45}, 1, true, []int{60, 30, 25}},
+		{"later reserves never go below zero", []int{60, 50, 10}, 1, true, []int{60, 30, 0}},
+		{"not enough sprint energy leaves the race untouched", []int{60, 15, 10}, 1, false, []int{60, 15, 10}},
+		{"a turn past the finish is refused", []int{60, 50}, 2, false, []int{60, 50}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updates := progress(tt.sprints...)
+			if ok := SpendWhip(updates, tt.turn, "player"); ok != tt.wantOK {
+				t.Errorf("SpendWhip() = %v, want %v", ok, tt.wantOK)
+			}
+			for i, want := range tt.wantSprints {
+				if got := updates[i].Energy["player"].Sprint; got != want {
+					t.Errorf("turn %d sprint = %d, want %d", i, got, want)
+				}
+			}
+		})
+	}
+}
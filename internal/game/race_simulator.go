@@ -3,6 +3,8 @@ package game
 import (
 	"fmt"
 	"math/rand"
+	"strconv"
+	"strings"
 
 	"goderby/internal/models"
 )
@@ -12,6 +14,10 @@ type RaceSimulator struct {
 	horses      map[string]*models.Horse
 	strategy    models.RaceStrategy
 	playerHorse string
+	cheers      map[string][]string // Race moment -> lines from the player's supporters
+	riders      models.Riders
+	commentator *models.Commentator
+	whipTurn    int // Turn the player's whip was last paid for from the sprint reserve
 }
 
 func NewRaceSimulator(race models.Race, horses map[string]*models.Horse, playerHorse string, strategy models.RaceStrategy) *RaceSimulator {
@@ -20,9 +26,25 @@ func NewRaceSimulator(race models.Race, horses map[string]*models.Horse, playerH
 		horses:      horses,
 		strategy:    strategy,
 		playerHorse: playerHorse,
+		commentator: models.NewCommentator(models.CommentaryPack{}),
 	}
 }
 
+// SetRiders puts jockeys on the horses; their pace judgment lifts speed late in the race
+func (rs *RaceSimulator) SetRiders(riders models.Riders) {
+	rs.riders = riders
+}
+
+// SetCommentary picks the commentary pack the race is called in
+func (rs *RaceSimulator) SetCommentary(pack models.CommentaryPack) {
+	rs.commentator = models.NewCommentator(pack)
+}
+
+// SetSupporterCheers gives the simulator lines the player's supporters call out, by race moment
+func (rs *RaceSimulator) SetSupporterCheers(cheers map[string][]string) {
+	rs.cheers = cheers
+}
+
 func (rs *RaceSimulator) Simulate() models.RaceResult {
 	numTurns := rs.race.Distance / 100 // 100m per turn
 	if numTurns < 10 {
@@ -32,7 +54,7 @@ func (rs *RaceSimulator) Simulate() models.RaceResult {
 	entrants := make([]models.RaceEntrant, 0, len(rs.race.Entrants))
 	positions := make(map[string]int)
 	distances := make(map[string]int)
-	stamina := make(map[string]int)
+	energy := make(models.EnergyMap)
 
 	// Initialize race state
 	for i, horseID := range rs.race.Entrants {
@@ -46,14 +68,23 @@ func (rs *RaceSimulator) Simulate() models.RaceResult {
 		entrants = append(entrants, entrant)
 		positions[horseID] = i + 1
 		distances[horseID] = 0
-		stamina[horseID] = horse.Stamina
+		energy[horseID] = models.NewEnergy(horse)
 	}
+	strategies := rs.fieldStrategies()
+	activeSkills := make(map[string]map[string]bool) // Horse ID -> skills active last turn
 
 	var liveProgress []models.RaceProgressUpdate
 	var commentary []string
 
-	commentary = append(commentary, "🏁 The race is about to begin!")
-	commentary = append(commentary, fmt.Sprintf("🏇 %d horses are lined up at the starting gate", len(rs.race.Entrants)))
+	narrator := newNarrator(rs.commentator, rs.horses, rs.playerHorse)
+	commentary = appendLine(commentary, rs.commentator.Say("preamble", "field", strconv.Itoa(len(rs.race.Entrants))))
+
+	// The gates open
+	breaks, gateUpdate := rs.startPhase()
+	gateUpdate.Energy = energySnapshot(energy)
+	liveProgress = append(liveProgress, gateUpdate)
+	commentary = appendLine(commentary, gateUpdate.Commentary)
+	commentary = append(commentary, gateUpdate.Events...)
 
 	// Race simulation
 	for turn := 1; turn <= numTurns; turn++ {
@@ -64,6 +95,7 @@ func (rs *RaceSimulator) Simulate() models.RaceResult {
 			Commentary: "",
 			Events:     make([]string, 0),
 		}
+		drafting := draftingHorses(distances)
 
 		// Calculate movement for each horse
 		for _, horseID := range rs.race.Entrants {
@@ -72,59 +104,44 @@ func (rs *RaceSimulator) Simulate() models.RaceResult {
 			// Base movement calculation
 			baseSpeed := rs.calculateHorseSpeed(horse, turn, numTurns)
 
-			// Apply strategy modifier if it's the player's horse
-			if horseID == rs.playerHorse {
-				baseSpeed = rs.applyStrategyModifier(baseSpeed, turn, numTurns)
-			}
+			// Equipped skills
+			baseSpeed = rs.applySkills(horse, baseSpeed, rs.raceSituation(horse, turn, numTurns, positions, energy), activeSkills, &turnUpdate)
+
+			// How hard the horse is ridden, as far as its energy allows
+			progress := float64(turn) / float64(numTurns)
+			effort := runTurn(energy, horseID, strategies[horseID], progress, positions[horseID], drafting[horseID])
 
-			// Random factor
+			// Random factor, and ground lost to a bad break
 			randomFactor := 0.8 + rand.Float64()*0.4 // 0.8 to 1.2
-			movement := int(float64(baseSpeed) * randomFactor)
-
-			// Stamina check
-			staminaCost := movement / 2
-			if stamina[horseID] >= staminaCost {
-				distances[horseID] += movement
-				stamina[horseID] -= staminaCost
-			} else {
-				// Reduced movement due to fatigue
-				distances[horseID] += movement / 2
-				stamina[horseID] = 0
+			movement := int(float64(baseSpeed) * effort * randomFactor * breaks[horseID].StartFactor(turn))
+			if turn > 1 && breaks[horseID].Recovering(turn-1) && !breaks[horseID].Recovering(turn) && breaks[horseID] != models.SlowStart {
+				if away := rs.commentator.Say("away", "horse", horse.Name); away != "" {
+					turnUpdate.Events = append(turnUpdate.Events, away)
+					commentary = append(commentary, away)
+				}
 			}
 
+			distances[horseID] += movement
 			turnUpdate.Distances[horseID] = distances[horseID]
 		}
 
 		// Update positions based on distance
+		before := make(map[string]int, len(positions))
+		for horseID, pos := range positions {
+			before[horseID] = pos
+		}
 		rs.updatePositions(positions, distances)
 		for horseID, pos := range positions {
 			turnUpdate.Positions[horseID] = pos
 		}
+		turnUpdate.Energy = energySnapshot(energy)
 
-		// Generate commentary
-		switch turn {
-		case 1:
-			turnUpdate.Commentary = "🏁 And they're off!"
-		case numTurns / 4:
-			leader := rs.getLeader(positions)
-			turnUpdate.Commentary = fmt.Sprintf("🎯 At the first quarter: %s takes the lead!", rs.horses[leader].Name)
-		case numTurns / 2:
-			leader := rs.getLeader(positions)
-			turnUpdate.Commentary = fmt.Sprintf("⚡ Halfway point: %s is still in front!", rs.horses[leader].Name)
-		case (numTurns * 3) / 4:
-			leader := rs.getLeader(positions)
-			turnUpdate.Commentary = fmt.Sprintf("🔥 Final quarter: %s leading into the home stretch!", rs.horses[leader].Name)
-		case numTurns:
-			leader := rs.getLeader(positions)
-			turnUpdate.Commentary = fmt.Sprintf("🏆 %s crosses the finish line first!", rs.horses[leader].Name)
-		}
+		// Call the race as it unfolds
+		turnUpdate.Commentary = narrator.call(turn, numTurns, before, positions, distances, energy)
 
-		// Random events
-		if rand.Float64() < 0.1 { // 10% chance of event
-			event := rs.generateRandomEvent()
-			if event != "" {
-				turnUpdate.Events = append(turnUpdate.Events, event)
-			}
+		// Supporters cheering from the stands
+		if cheer := rs.supporterCheer(turn, numTurns, positions); cheer != "" {
+			turnUpdate.Cheers = append(turnUpdate.Cheers, cheer)
 		}
 
 		liveProgress = append(liveProgress, turnUpdate)
@@ -132,6 +149,8 @@ func (rs *RaceSimulator) Simulate() models.RaceResult {
 		if turnUpdate.Commentary != "" {
 			commentary = append(commentary, turnUpdate.Commentary)
 		}
+		commentary = append(commentary, turnUpdate.Cheers...)
+		commentary = append(commentary, turnUpdate.SkillActivations...)
 	}
 
 	// Final results
@@ -140,6 +159,10 @@ func (rs *RaceSimulator) Simulate() models.RaceResult {
 		entrant.Position = positions[entrant.HorseID]
 		entrant.Distance = distances[entrant.HorseID]
 		entrant.Time = rs.calculateFinishTime(entrant.Distance, rs.race.Distance)
+		entrant.Weight = rs.race.WeightCarried(entrant.HorseID)
+		entrant.Jockey = rs.riders[entrant.HorseID].Name
+		entrant.Stall = rs.race.Stall(entrant.HorseID)
+		entrant.Break = breaks[entrant.HorseID]
 		finalEntrants = append(finalEntrants, entrant)
 	}
 
@@ -185,15 +208,25 @@ func (rs *RaceSimulator) calculateHorseSpeed(horse *models.Horse, turn, totalTur
 	// Age affects race performance
 	ageFactor := horse.GetAgePerformanceFactor()
 
-	// Stamina affects endurance throughout race
+	// Distance aptitude favours horses suited to this trip
+	distanceFactor := horse.GetDistanceFactor(rs.race.Distance)
+
 	raceProgress := float64(turn) / float64(totalTurns)
-	staminaFactor := 1.0
-	if raceProgress > 0.5 {
-		staminaFactor = float64(horse.Stamina) / 100.0
+
+	// Weight carried slows horses above the standard weight
+	weightFactor := models.WeightFactor(rs.race.WeightCarried(horse.ID))
+
+	// The draw helps or hinders horses until the field settles
+	drawFactor := rs.race.DrawFactor(horse.ID, raceProgress)
+
+	// A jockey who judges the pace well finds more speed late on
+	paceFactor := 1.0
+	if jockey, ok := rs.riders[horse.ID]; ok {
+		paceFactor = models.PaceFactor(jockey.Pace, raceProgress)
 	}
 
 	speed := baseSpeed + techniqueBonus + mentalBonus - fatiguePenalty
-	speed = int(float64(speed) * staminaFactor * ageFactor)
+	speed = int(float64(speed) * ageFactor * distanceFactor * weightFactor * drawFactor * paceFactor)
 
 	if speed < 1 {
 		speed = 1
@@ -202,46 +235,108 @@ func (rs *RaceSimulator) calculateHorseSpeed(horse *models.Horse, turn, totalTur
 	return speed
 }
 
-func (rs *RaceSimulator) applyStrategyModifier(baseSpeed int, turn, totalTurns int) int {
-	raceProgress := float64(turn) / float64(totalTurns)
+// startPhase loads the field into the stalls and rolls how each horse breaks, returning the
+// breaks and the progress update shown as the gates open
+func (rs *RaceSimulator) startPhase() (map[string]models.Break, models.RaceProgressUpdate) {
+	breaks := make(map[string]models.Break, len(rs.race.Entrants))
+	gateUpdate := models.RaceProgressUpdate{
+		Turn:       0,
+		Positions:  make(map[string]int),
+		Distances:  make(map[string]int),
+		Commentary: rs.commentator.Say("gates"),
+		Events:     make([]string, 0),
+	}
 
-	switch rs.strategy.Formation {
-	case models.Lead:
-		// Start fast, maintain lead
-		if raceProgress < 0.3 {
-			return int(float64(baseSpeed) * 1.2)
+	for i, horseID := range rs.race.Entrants {
+		horse := rs.horses[horseID]
+		gateUpdate.Distances[horseID] = 0
+		gateUpdate.Positions[horseID] = i + 1
+		if stall := rs.race.Stall(horseID); stall > 0 {
+			gateUpdate.Positions[horseID] = stall
 		}
-		return baseSpeed
-	case models.Draft:
-		// Stay mid-pack, surge in final stretch
-		if raceProgress > 0.7 {
-			return int(float64(baseSpeed) * 1.3)
+
+		breaks[horseID] = horse.BreakFromGate()
+		cue := ""
+		switch breaks[horseID] {
+		case models.SlowStart:
+			cue = "slow_start"
+		case models.Reared:
+			cue = "reared"
+		case models.GateRefusal:
+			cue = "refused"
+		}
+		gateUpdate.Events = appendLine(gateUpdate.Events, rs.commentator.Say(cue, "horse", horse.Name))
+	}
+
+	if stall := rs.race.Stall(rs.playerHorse); stall > 0 {
+		gateUpdate.Events = appendLine(gateUpdate.Events, rs.commentator.Say("draw",
+			"horse", rs.horses[rs.playerHorse].Name, "stall", strconv.Itoa(stall), "field", strconv.Itoa(len(rs.race.Entrants)),
+			"draw", strings.ToLower(rs.race.DrawLabel(rs.playerHorse)), "course", strings.ToLower(rs.race.Course.String())))
+	}
+
+	return breaks, gateUpdate
+}
+
+// raceSituation describes a horse's state at the start of a turn for skill checks
+func (rs *RaceSimulator) raceSituation(horse *models.Horse, turn, totalTurns int, positions map[string]int, energy models.EnergyMap) models.RaceSituation {
+	return models.RaceSituation{
+		Progress:     float64(turn) / float64(totalTurns),
+		Position:     positions[horse.ID],
+		FieldSize:    len(rs.race.Entrants),
+		StaminaRatio: energy[horse.ID].AerobicRatio(),
+		Weather:      rs.race.Weather,
+	}
+}
+
+// applySkills boosts speed for each equipped skill whose trigger is met, announcing
+// skills as they activate
+func (rs *RaceSimulator) applySkills(horse *models.Horse, speed int, situation models.RaceSituation, activeSkills map[string]map[string]bool, turnUpdate *models.RaceProgressUpdate) int {
+	if activeSkills[horse.ID] == nil {
+		activeSkills[horse.ID] = make(map[string]bool)
+	}
+	active := activeSkills[horse.ID]
+
+	boosted := float64(speed)
+	for _, skill := range horse.GetEquippedSkills() {
+		if !skill.IsActive(situation) {
+			active[skill.ID] = false
+			continue
 		}
-		return int(float64(baseSpeed) * 0.9)
-	case models.Mount:
-		// Conservative start, strong finish
-		if raceProgress > 0.8 {
-			return int(float64(baseSpeed) * 1.4)
+
+		boosted *= skill.Boost
+		if !active[skill.ID] {
+			activation := fmt.Sprintf("%s %s activates %s!", skill.Icon, horse.Name, skill.Name)
+			turnUpdate.Events = append(turnUpdate.Events, activation)
+			turnUpdate.SkillActivations = append(turnUpdate.SkillActivations, activation)
 		}
-		return int(float64(baseSpeed) * 0.8)
+		active[skill.ID] = true
 	}
 
-	switch rs.strategy.Pace {
-	case models.Fast:
-		if raceProgress < 0.5 {
-			return int(float64(baseSpeed) * 1.2)
+	return int(boosted)
+}
+
+// supporterCheer picks a supporter's line at the start, in the stretch when the player's horse
+// is in the top 3, and at the finish when it wins
+func (rs *RaceSimulator) supporterCheer(turn, totalTurns int, positions map[string]int) string {
+	moment := ""
+	switch {
+	case turn == 1:
+		moment = "start"
+	case turn == totalTurns:
+		if positions[rs.playerHorse] == 1 {
+			moment = "win"
 		}
-		return int(float64(baseSpeed) * 0.8)
-	case models.Even:
-		return baseSpeed
-	case models.Conserve:
-		if raceProgress > 0.6 {
-			return int(float64(baseSpeed) * 1.1)
+	case turn == (totalTurns*3)/4:
+		if positions[rs.playerHorse] <= 3 {
+			moment = "stretch"
 		}
-		return int(float64(baseSpeed) * 0.9)
 	}
 
-	return baseSpeed
+	lines := rs.cheers[moment]
+	if len(lines) == 0 {
+		return ""
+	}
+	return lines[rand.Intn(len(lines))]
 }
 
 func (rs *RaceSimulator) updatePositions(positions map[string]int, distances map[string]int) {
@@ -266,31 +361,6 @@ func (rs *RaceSimulator) updatePositions(positions map[string]int, distances map
 	}
 }
 
-func (rs *RaceSimulator) getLeader(positions map[string]int) string {
-	for horseID, pos := range positions {
-		if pos == 1 {
-			return horseID
-		}
-	}
-	return ""
-}
-
-func (rs *RaceSimulator) generateRandomEvent() string {
-	events := []string{
-		"A gust of wind affects the field!",
-		"The crowd cheers loudly!",
-		"Some horses are bunching up!",
-		"The pace is picking up!",
-		"A horse stumbles but recovers!",
-	}
-
-	if rand.Float64() < 0.5 {
-		return events[rand.Intn(len(events))]
-	}
-
-	return ""
-}
-
 func (rs *RaceSimulator) calculateFinishTime(distance, raceDistance int) string {
 	// Simple time calculation based on distance covered
 	baseTime := 120.0 // 2 minutes base
@@ -309,7 +379,6 @@ func (rs *RaceSimulator) calculateFinishTime(distance, raceDistance int) string
 // InteractiveRaceModel interface to avoid circular imports
 type InteractiveRaceModel interface {
 	GetPlayerLane() int
-	GetRaceStamina() int
 	GetWhipUses() int
 	IsDisobedient() bool
 	GetLastWhipTurn() int
@@ -325,7 +394,7 @@ func (rs *RaceSimulator) SimulateInteractive(raceModel InteractiveRaceModel) mod
 	entrants := make([]models.RaceEntrant, 0, len(rs.race.Entrants))
 	positions := make(map[string]int)
 	distances := make(map[string]int)
-	stamina := make(map[string]int)
+	energy := make(models.EnergyMap)
 
 	// Initialize race state
 	for i, horseID := range rs.race.Entrants {
@@ -339,14 +408,23 @@ func (rs *RaceSimulator) SimulateInteractive(raceModel InteractiveRaceModel) mod
 		entrants = append(entrants, entrant)
 		positions[horseID] = i + 1
 		distances[horseID] = 0
-		stamina[horseID] = horse.Stamina
+		energy[horseID] = models.NewEnergy(horse)
 	}
+	strategies := rs.fieldStrategies()
+	activeSkills := make(map[string]map[string]bool) // Horse ID -> skills active last turn
 
 	var liveProgress []models.RaceProgressUpdate
 	var commentary []string
 
-	commentary = append(commentary, "🏁 The race is about to begin!")
-	commentary = append(commentary, fmt.Sprintf("🏇 %d horses are lined up at the starting gate", len(rs.race.Entrants)))
+	narrator := newNarrator(rs.commentator, rs.horses, rs.playerHorse)
+	commentary = appendLine(commentary, rs.commentator.Say("preamble", "field", strconv.Itoa(len(rs.race.Entrants))))
+
+	// The gates open
+	breaks, gateUpdate := rs.startPhase()
+	gateUpdate.Energy = energySnapshot(energy)
+	liveProgress = append(liveProgress, gateUpdate)
+	commentary = appendLine(commentary, gateUpdate.Commentary)
+	commentary = append(commentary, gateUpdate.Events...)
 
 	// Race simulation
 	for turn := 1; turn <= numTurns; turn++ {
@@ -357,6 +435,7 @@ func (rs *RaceSimulator) SimulateInteractive(raceModel InteractiveRaceModel) mod
 			Commentary: "",
 			Events:     make([]string, 0),
 		}
+		drafting := draftingHorses(distances)
 
 		// Calculate movement for each horse
 		for _, horseID := range rs.race.Entrants {
@@ -365,62 +444,49 @@ func (rs *RaceSimulator) SimulateInteractive(raceModel InteractiveRaceModel) mod
 			// Base movement calculation
 			baseSpeed := rs.calculateHorseSpeed(horse, turn, numTurns)
 
-			// Apply strategy modifier if it's the player's horse
+			// Apply interactive controls for player horse
 			if horseID == rs.playerHorse {
-				baseSpeed = rs.applyStrategyModifier(baseSpeed, turn, numTurns)
-
-				// Apply interactive controls for player horse
-				baseSpeed = rs.applyInteractiveModifiers(baseSpeed, turn, raceModel)
+				baseSpeed = rs.applyInteractiveModifiers(baseSpeed, turn, raceModel, energy)
 			}
 
-			// Random factor
+			// Equipped skills
+			baseSpeed = rs.applySkills(horse, baseSpeed, rs.raceSituation(horse, turn, numTurns, positions, energy), activeSkills, &turnUpdate)
+
+			// How hard the horse is ridden, as far as its energy allows
+			progress := float64(turn) / float64(numTurns)
+			effort := runTurn(energy, horseID, strategies[horseID], progress, positions[horseID], drafting[horseID])
+
+			// Random factor, and ground lost to a bad break
 			randomFactor := 0.8 + rand.Float64()*0.4 // 0.8 to 1.2
-			movement := int(float64(baseSpeed) * randomFactor)
-
-			// Stamina check
-			staminaCost := movement / 2
-			if stamina[horseID] >= staminaCost {
-				distances[horseID] += movement
-				stamina[horseID] -= staminaCost
-			} else {
-				// Reduced movement due to fatigue
-				distances[horseID] += movement / 2
-				stamina[horseID] = 0
+			movement := int(float64(baseSpeed) * effort * randomFactor * breaks[horseID].StartFactor(turn))
+			if turn > 1 && breaks[horseID].Recovering(turn-1) && !breaks[horseID].Recovering(turn) && breaks[horseID] != models.SlowStart {
+				if away := rs.commentator.Say("away", "horse", horse.Name); away != "" {
+					turnUpdate.Events = append(turnUpdate.Events, away)
+					commentary = append(commentary, away)
+				}
 			}
 
+			distances[horseID] += movement
 			turnUpdate.Distances[horseID] = distances[horseID]
 		}
 
 		// Update positions based on distance
+		before := make(map[string]int, len(positions))
+		for horseID, pos := range positions {
+			before[horseID] = pos
+		}
 		rs.updatePositions(positions, distances)
 		for horseID, pos := range positions {
 			turnUpdate.Positions[horseID] = pos
 		}
+		turnUpdate.Energy = energySnapshot(energy)
 
-		// Generate commentary
-		switch turn {
-		case 1:
-			turnUpdate.Commentary = "🏁 And they're off!"
-		case numTurns / 4:
-			leader := rs.getLeader(positions)
-			turnUpdate.Commentary = fmt.Sprintf("🎯 At the first quarter: %s takes the lead!", rs.horses[leader].Name)
-		case numTurns / 2:
-			leader := rs.getLeader(positions)
-			turnUpdate.Commentary = fmt.Sprintf("⚡ Halfway point: %s is still in front!", rs.horses[leader].Name)
-		case (numTurns * 3) / 4:
-			leader := rs.getLeader(positions)
-			turnUpdate.Commentary = fmt.Sprintf("🔥 Final quarter: %s leading into the home stretch!", rs.horses[leader].Name)
-		case numTurns:
-			leader := rs.getLeader(positions)
-			turnUpdate.Commentary = fmt.Sprintf("🏆 %s crosses the finish line first!", rs.horses[leader].Name)
-		}
+		// Call the race as it unfolds
+		turnUpdate.Commentary = narrator.call(turn, numTurns, before, positions, distances, energy)
 
-		// Random events
-		if rand.Float64() < 0.1 { // 10% chance of event
-			event := rs.generateRandomEvent()
-			if event != "" {
-				turnUpdate.Events = append(turnUpdate.Events, event)
-			}
+		// Supporters cheering from the stands
+		if cheer := rs.supporterCheer(turn, numTurns, positions); cheer != "" {
+			turnUpdate.Cheers = append(turnUpdate.Cheers, cheer)
 		}
 
 		liveProgress = append(liveProgress, turnUpdate)
@@ -428,6 +494,8 @@ func (rs *RaceSimulator) SimulateInteractive(raceModel InteractiveRaceModel) mod
 		if turnUpdate.Commentary != "" {
 			commentary = append(commentary, turnUpdate.Commentary)
 		}
+		commentary = append(commentary, turnUpdate.Cheers...)
+		commentary = append(commentary, turnUpdate.SkillActivations...)
 	}
 
 	// Final results
@@ -436,6 +504,10 @@ func (rs *RaceSimulator) SimulateInteractive(raceModel InteractiveRaceModel) mod
 		entrant.Position = positions[entrant.HorseID]
 		entrant.Distance = distances[entrant.HorseID]
 		entrant.Time = rs.calculateFinishTime(entrant.Distance, rs.race.Distance)
+		entrant.Weight = rs.race.WeightCarried(entrant.HorseID)
+		entrant.Jockey = rs.riders[entrant.HorseID].Name
+		entrant.Stall = rs.race.Stall(entrant.HorseID)
+		entrant.Break = breaks[entrant.HorseID]
 		finalEntrants = append(finalEntrants, entrant)
 	}
 
@@ -465,11 +537,20 @@ func (rs *RaceSimulator) SimulateInteractive(raceModel InteractiveRaceModel) mod
 	}
 }
 
-func (rs *RaceSimulator) applyInteractiveModifiers(baseSpeed int, turn int, raceModel InteractiveRaceModel) int {
+func (rs *RaceSimulator) applyInteractiveModifiers(baseSpeed int, turn int, raceModel InteractiveRaceModel, energy models.EnergyMap) int {
 	modifiedSpeed := baseSpeed
 
+	// The whip is paid for from the sprint reserve on the turn it's used
+	if whipTurn := raceModel.GetLastWhipTurn(); whipTurn == turn && whipTurn != rs.whipTurn {
+		reserves := energy[rs.playerHorse]
+		if reserves.Spend(models.WhipCost) {
+			energy[rs.playerHorse] = reserves
+			rs.whipTurn = whipTurn
+		}
+	}
+
 	// Whip boost effect - lasts for a few turns after use
-	if raceModel.GetLastWhipTurn() > 0 && turn-raceModel.GetLastWhipTurn() <= 2 {
+	if rs.whipTurn > 0 && turn-rs.whipTurn <= 2 {
 		whipBoost := 1.5 // 50% speed boost
 		modifiedSpeed = int(float64(modifiedSpeed) * whipBoost)
 	}
@@ -0,0 +1,112 @@
+package models
+
+import "fmt"
+
+// MaxBond is the highest a supporter's bond meter can reach
+const MaxBond = 100
+
+// BondPerSession is the bond a supporter gains from training their specialty with the horse
+const BondPerSession = 4
+
+// FriendshipSupporters is how many best-friend supporters must share a session for friendship training
+const FriendshipSupporters = 2
+
+// FriendshipMultiplier boosts the gains of a friendship training session
+const FriendshipMultiplier = 1.5
+
+// bondThresholds are the bond needed for each bond level
+var bondThresholds = []int{0, 20, 50, 80}
+
+var bondLevelNames = []string{"Acquainted", "Friendly", "Close", "Best Friends"}
+
+// BondLevel returns the supporter's bond level, 0 to 3
+func (s Supporter) BondLevel() int {
+	level := 0
+	for i, threshold := range bondThresholds {
+		if s.Bond >= threshold {
+			level = i
+		}
+	}
+	return level
+}
+
+// BondLevelName describes the supporter's bond level
+func (s Supporter) BondLevelName() string {
+	return bondLevelNames[s.BondLevel()]
+}
+
+// NextBondThreshold returns the bond needed for the next level, or 0 at the top level
+func (s Supporter) NextBondThreshold() int {
+	level := s.BondLevel()
+	if level+1 >= len(bondThresholds) {
+		return 0
+	}
+	return bondThresholds[level+1]
+}
+
+// IsBestFriend reports whether the supporter has reached the top bond level
+func (s Supporter) IsBestFriend() bool {
+	return s.BondLevel() == len(bondThresholds)-1
+}
+
+// Specializes reports whether the supporter boosts the stat a training type improves
+func (s Supporter) Specializes(trainingType TrainingType) bool {
+	return s.TrainingBonus[trainingType.Stat()] > 0
+}
+
+// EffectiveBonus is the supporter's training bonus after card level, limit break and bond
+func (s Supporter) EffectiveBonus(trainingType TrainingType) int {
+	bonus := s.TrainingBonus[trainingType.Stat()]
+	if bonus <= 0 {
+		return 0
+	}
+	return bonus*s.cardBonusPercent()/100 + s.LimitBreak
+}
+
+// FriendshipPartners returns the best-friend supporters who specialise in a training type
+func FriendshipPartners(supporters []Supporter, trainingType TrainingType) []Supporter {
+	var partners []Supporter
+	for _, supporter := range supporters {
+		if supporter.IsBestFriend() && supporter.Specializes(trainingType) {
+			partners = append(partners, supporter)
+		}
+	}
+	return partners
+}
+
+// friendshipMultiplier returns the gain multiplier when enough best friends train together
+func friendshipMultiplier(supporters []Supporter, trainingType TrainingType) float64 {
+	if len(FriendshipPartners(supporters, trainingType)) >= FriendshipSupporters {
+		return FriendshipMultiplier
+	}
+	return 1.0
+}
+
+// GetSupporter looks up an owned or unowned supporter card by ID
+func (gs *GameState) GetSupporter(supporterID string) *Supporter {
+	for i := range gs.Supporters {
+		if gs.Supporters[i].ID == supporterID {
+			return &gs.Supporters[i]
+		}
+	}
+	return nil
+}
+
+// growBonds raises the bond of the supporters who helped with a session of their specialty
+// and returns a message for each supporter that reached a new bond level
+func (gs *GameState) growBonds(supporters []Supporter, trainingType TrainingType) []string {
+	var levelUps []string
+	for _, helper := range supporters {
+		supporter := gs.GetSupporter(helper.ID)
+		if supporter == nil || !supporter.Specializes(trainingType) {
+			continue // Mentors and unknown cards have no bond meter
+		}
+
+		level := supporter.BondLevel()
+		supporter.Bond = min(supporter.Bond+BondPerSession, MaxBond)
+		if supporter.BondLevel() > level {
+			levelUps = append(levelUps, fmt.Sprintf("%s: %s", supporter.Name, supporter.BondLevelName()))
+		}
+	}
+	return levelUps
+}
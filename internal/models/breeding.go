@@ -0,0 +1,254 @@
+package models
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+)
+
+// Pedigree records the parentage of a horse bred in the stable
+type Pedigree struct {
+	SireID     string `json:"sire_id"`
+	SireName   string `json:"sire_name"`
+	DamID      string `json:"dam_id"`
+	DamName    string `json:"dam_name"`
+	Grandsire  string `json:"grandsire"`  // Sire's sire, if known
+	Damsire    string `json:"damsire"`    // Dam's sire, if known
+	Generation int    `json:"generation"` // 1 = foal of scouted horses
+}
+
+// OutsideStud is a stallion from another farm that can be hired for a fee
+type OutsideStud struct {
+	Horse Horse `json:"horse"`
+	Fee   int   `json:"fee"`
+}
+
+// DefaultOutsideStuds returns the stallions available for hire from other farms
+func DefaultOutsideStuds() []OutsideStud {
+	return []OutsideStud{
+		newOutsideStud("stud_001", "Prairie King", "Quarter Horse", Stats{Stamina: 150, Speed: 190, Technique: 140, Mental: 130},
+			Aptitudes{Sprint: 85, Mile: 70, Medium: 45, Long: 25}, 5000),
+		newOutsideStud("stud_002", "Iron Duke", "Clydesdale", Stats{Stamina: 210, Speed: 130, Technique: 150, Mental: 170},
+			Aptitudes{Sprint: 30, Mile: 50, Medium: 70, Long: 85}, 8000),
+		newOutsideStud("stud_003", "Desert Monarch", "Arabian", Stats{Stamina: 230, Speed: 200, Technique: 180, Mental: 190},
+			Aptitudes{Sprint: 40, Mile: 60, Medium: 80, Long: 90}, 20000),
+		newOutsideStud("stud_004", "Northern Legend", "Thoroughbred", Stats{Stamina: 240, Speed: 250, Technique: 220, Mental: 210},
+			Aptitudes{Sprint: 60, Mile: 85, Medium: 90, Long: 70}, 50000),
+	}
+}
+
+// newOutsideStud builds a fully trained stud. Like a home-bred horse, its caps sit 200 above
+// its natural ability, taken as half its trained stats.
+func newOutsideStud(id, name, breed string, stats Stats, aptitudes Aptitudes, fee int) OutsideStud {
+	return OutsideStud{
+		Horse: Horse{
+			ID:           id,
+			Name:         name,
+			Breed:        breed,
+			Age:          9,
+			Stamina:      stats.Stamina,
+			Speed:        stats.Speed,
+			Technique:    stats.Technique,
+			Mental:       stats.Mental,
+			MaxStamina:   stats.Stamina/2 + 200,
+			MaxSpeed:     stats.Speed/2 + 200,
+			MaxTechnique: stats.Technique/2 + 200,
+			MaxMental:    stats.Mental/2 + 200,
+			Morale:       100,
+			IsRetired:    true,
+			Sex:          Stallion,
+			Aptitudes:    aptitudes,
+		},
+		Fee: fee,
+	}
+}
+
+// BreedFoal produces a foal whose stats, aptitudes and breed are inherited from its parents with variance
+func BreedFoal(sire, dam *Horse) *Horse {
+	baseStats := Stats{
+		Stamina:   inheritBaseStat(sire.MaxStamina, dam.MaxStamina, sire.Stamina, dam.Stamina),
+		Speed:     inheritBaseStat(sire.MaxSpeed, dam.MaxSpeed, sire.Speed, dam.Speed),
+		Technique: inheritBaseStat(sire.MaxTechnique, dam.MaxTechnique, sire.Technique, dam.Technique),
+		Mental:    inheritBaseStat(sire.MaxMental, dam.MaxMental, sire.Mental, dam.Mental),
+	}
+
+	breed := sire.Breed
+	if sire.Breed != dam.Breed && rand.Intn(2) == 1 {
+		breed = dam.Breed
+	}
+
+	foal := NewHorse(foalName(sire.Name, dam.Name), breed, baseStats)
+
+	// Potential varies from foal to foal
+	foal.MaxStamina += rand.Intn(31) - 10
+	foal.MaxSpeed += rand.Intn(31) - 10
+	foal.MaxTechnique += rand.Intn(31) - 10
+	foal.MaxMental += rand.Intn(31) - 10
+
+	foal.Sex = Sex(rand.Intn(2))
+	foal.Aptitudes = inheritAptitudes(sire.Aptitudes, dam.Aptitudes)
+
+	generation := 1
+	if sire.Pedigree != nil && sire.Pedigree.Generation >= generation {
+		generation = sire.Pedigree.Generation + 1
+	}
+	if dam.Pedigree != nil && dam.Pedigree.Generation >= generation {
+		generation = dam.Pedigree.Generation + 1
+	}
+
+	foal.Pedigree = &Pedigree{
+		SireID:     sire.ID,
+		SireName:   sire.Name,
+		DamID:      dam.ID,
+		DamName:    dam.Name,
+		Generation: generation,
+	}
+	if sire.Pedigree != nil {
+		foal.Pedigree.Grandsire = sire.Pedigree.SireName
+	}
+	if dam.Pedigree != nil {
+		foal.Pedigree.Damsire = dam.Pedigree.SireName
+	}
+
+	return foal
+}
+
+// inheritBaseStat averages the parents' natural ability (max - 200) and adds
+// a small bonus for how well the parents were trained, plus variance
+func inheritBaseStat(sireMax, damMax, sireStat, damStat int) int {
+	natural := (sireMax - 200 + damMax - 200) / 2
+	trainedBonus := (sireStat + damStat) / 2 / 20
+	stat := natural + trainedBonus + rand.Intn(17) - 8
+	return min(max(stat, 30), 130)
+}
+
+func inheritAptitudes(sire, dam Aptitudes) Aptitudes {
+	if sire.IsZero() {
+		sire = Aptitudes{Sprint: 50, Mile: 50, Medium: 50, Long: 50}
+	}
+	if dam.IsZero() {
+		dam = Aptitudes{Sprint: 50, Mile: 50, Medium: 50, Long: 50}
+	}
+
+	inherit := func(a, b int) int {
+		return min(max((a+b)/2+rand.Intn(21)-10, 10), 100)
+	}
+
+	return Aptitudes{
+		Sprint: inherit(sire.Sprint, dam.Sprint),
+		Mile:   inherit(sire.Mile, dam.Mile),
+		Medium: inherit(sire.Medium, dam.Medium),
+		Long:   inherit(sire.Long, dam.Long),
+	}
+}
+
+// foalName combines the first word of the sire's name with the last word of the dam's
+func foalName(sireName, damName string) string {
+	sireWords := strings.Fields(sireName)
+	damWords := strings.Fields(damName)
+	if len(sireWords) == 0 || len(damWords) == 0 {
+		return "Unnamed Foal"
+	}
+
+	first := sireWords[0]
+	last := damWords[len(damWords)-1]
+	if first == last && len(damWords) > 1 {
+		last = damWords[0]
+	}
+	return first + " " + last
+}
+
+// GetStudHorses returns retired horses standing at stud
+func (gs *GameState) GetStudHorses() []RetiredHorse {
+	var studs []RetiredHorse
+	for _, retired := range gs.RetiredHorses {
+		if retired.PostRetirementRole == StudHorse {
+			studs = append(studs, retired)
+		}
+	}
+	return studs
+}
+
+// GetBreedingMares returns retired horses assigned to the breeding program as mares
+func (gs *GameState) GetBreedingMares() []RetiredHorse {
+	var mares []RetiredHorse
+	for _, retired := range gs.RetiredHorses {
+		if retired.PostRetirementRole == BreedingMare {
+			mares = append(mares, retired)
+		}
+	}
+	return mares
+}
+
+// CanBreedMare checks if a mare has not yet been bred this season
+func (gs *GameState) CanBreedMare(damID string) bool {
+	for _, retired := range gs.RetiredHorses {
+		if retired.Horse.ID == damID && retired.PostRetirementRole == BreedingMare {
+			return retired.LastBredSeason != gs.Season.Number
+		}
+	}
+	return false
+}
+
+// Breed pairs a stallion (own stud or outside stud) with a retired mare and
+// adds the resulting foal to the scouting pool
+func (gs *GameState) Breed(sireID, damID string) (*Horse, error) {
+	var dam *RetiredHorse
+	for i := range gs.RetiredHorses {
+		if gs.RetiredHorses[i].Horse.ID == damID {
+			dam = &gs.RetiredHorses[i]
+			break
+		}
+	}
+	if dam == nil || dam.PostRetirementRole != BreedingMare {
+		return nil, fmt.Errorf("mare not found in breeding program")
+	}
+	if dam.LastBredSeason == gs.Season.Number {
+		return nil, fmt.Errorf("%s has already been bred this season", dam.Horse.Name)
+	}
+
+	var sire *Horse
+	fee := 0
+	for i := range gs.RetiredHorses {
+		if gs.RetiredHorses[i].Horse.ID == sireID && gs.RetiredHorses[i].PostRetirementRole == StudHorse {
+			sire = &gs.RetiredHorses[i].Horse
+			break
+		}
+	}
+	if sire == nil {
+		for _, stud := range DefaultOutsideStuds() {
+			if stud.Horse.ID == sireID {
+				studHorse := stud.Horse
+				sire = &studHorse
+				fee = stud.Fee
+				break
+			}
+		}
+	}
+	if sire == nil {
+		return nil, fmt.Errorf("stallion not found")
+	}
+
+	if fee > 0 {
+		if err := gs.Spend(StudFeeExpense, fmt.Sprintf("Stud fee: %s x %s", sire.Name, dam.Horse.Name), fee, ""); err != nil {
+			return nil, fmt.Errorf("insufficient funds for stud fee")
+		}
+	}
+
+	foal := BreedFoal(sire, &dam.Horse)
+	dam.LastBredSeason = gs.Season.Number
+	dam.FoalsProduced++
+
+	gs.Foals = append(gs.Foals, *foal)
+	return foal, nil
+}
+
+// RemoveFoal takes a foal out of the scouting pool once it has been chosen
+func (gs *GameState) RemoveFoal(foalID string) {
+	for i, foal := range gs.Foals {
+		if foal.ID == foalID {
+			gs.Foals = append(gs.Foals[:i], gs.Foals[i+1:]...)
+			return
+		}
+	}
+}
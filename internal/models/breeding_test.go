@@ -0,0 +1,30 @@
+package models
+
+import "testing"
+
+func TestInheritBaseStat(t *testing.T) {
+	stud := newOutsideStud("stud", "Test Stud", "Thoroughbred", Stats{Stamina: 240, Speed: 250, Technique: 220, Mental: 210}, Aptitudes{}, 0)
+
+	tests := []struct {
+		name              string
+		sireMax, damMax   int
+		sireStat, damStat int
+		low, high         int
+	}{
+		{"averages natural ability and adds a training bonus", 300, 300, 200, 200, 102, 118},
+		{"an outside stud passes on positive natural ability", stud.Horse.MaxStamina, 300, stud.Horse.Stamina, 150, 111, 127},
+		{"untalented parents still give the minimum", 200, 200, 0, 0, 30, 30},
+		{"elite parents are held to the maximum", 400, 400, 400, 400, 130, 130},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The stat has up to 8 points of variance either way
+			for i := 0; i < 200; i++ {
+				if stat := inheritBaseStat(tt.sireMax, tt.damMax, tt.sireStat, tt.damStat); stat < tt.low || stat > tt.high {
+					t.Fatalf("inheritBaseStat() = %d, want %d-%d", stat, tt.low, tt.high)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package models
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// MaxLimitBreak is how many times a supporter card can be limit broken with duplicates
+const MaxLimitBreak = 4
+
+// baseLevelCap is a card's level cap before any limit breaks; each break raises it by levelCapPerBreak
+const (
+	baseLevelCap     = 10
+	levelCapPerBreak = 5
+)
+
+// CardDrop describes what the player received when a supporter card was drawn
+type CardDrop struct {
+	Supporter   *Supporter
+	Duplicate   bool // The card was already owned
+	LimitBroken bool // The duplicate raised the card's limit break
+	Materials   int  // Materials given for a duplicate of a fully limit broken card
+}
+
+// Message describes the drop for notices and reward screens
+func (d CardDrop) Message() string {
+	switch {
+	case !d.Duplicate:
+		return fmt.Sprintf("🎉 New supporter: %s %s", d.Supporter.Rarity.String(), d.Supporter.Name)
+	case d.LimitBroken:
+		return fmt.Sprintf("💎 Duplicate %s: limit break %d/%d, level cap now %d",
+			d.Supporter.Name, d.Supporter.LimitBreak, MaxLimitBreak, d.Supporter.LevelCap())
+	default:
+		return fmt.Sprintf("📘 Duplicate %s converted into %d training manuals", d.Supporter.Name, d.Materials)
+	}
+}
+
+// CardLevel returns the card's level; cards from older saves start at level 1
+func (s Supporter) CardLevel() int {
+	return max(s.Level, 1)
+}
+
+// LevelCap returns the highest level the card can reach at its limit break
+func (s Supporter) LevelCap() int {
+	return baseLevelCap + levelCapPerBreak*s.LimitBreak
+}
+
+// LevelUpCost returns the training manuals needed for the next level, scaling with rarity
+func (s Supporter) LevelUpCost() int {
+	return s.CardLevel() * 5 * (int(s.Rarity) + 1)
+}
+
+// cardBonusPercent is the card's bonus as a percentage of its base bonus: +5% per level
+// above 1 and +20% per bond level
+func (s Supporter) cardBonusPercent() int {
+	return 100 + 5*(s.CardLevel()-1) + 20*s.BondLevel()
+}
+
+// duplicateMaterials is what a duplicate of a fully limit broken card converts into
+func duplicateMaterials(rarity Rarity) int {
+	return 20 * (int(rarity) + 1)
+}
+
+// AcquireSupporter gives the player a random card of the rarity. Unowned cards are preferred;
+// otherwise the draw is a duplicate, which limit breaks the card or becomes training manuals.
+func (gs *GameState) AcquireSupporter(rarity Rarity) *CardDrop {
+	var unowned, owned []int
+	for i, supporter := range gs.Supporters {
+		if supporter.Rarity != rarity {
+			continue
+		}
+		if supporter.IsOwned {
+			owned = append(owned, i)
+		} else {
+			unowned = append(unowned, i)
+		}
+	}
+
+	if len(unowned) > 0 {
+		return gs.grantSupporter(&gs.Supporters[unowned[rand.Intn(len(unowned))]])
+	}
+	if len(owned) == 0 {
+		return nil
+	}
+	return gs.grantSupporter(&gs.Supporters[owned[rand.Intn(len(owned))]])
+}
+
+// grantSupporter gives the player a copy of a specific card, as new or as a duplicate
+func (gs *GameState) grantSupporter(supporter *Supporter) *CardDrop {
+	if !supporter.IsOwned {
+		supporter.IsOwned = true
+		return &CardDrop{Supporter: supporter}
+	}
+
+	drop := &CardDrop{Supporter: supporter, Duplicate: true}
+	if supporter.LimitBreak < MaxLimitBreak {
+		supporter.LimitBreak++
+		drop.LimitBroken = true
+		return drop
+	}
+
+	drop.Materials = duplicateMaterials(supporter.Rarity)
+	gs.TrainingManuals += drop.Materials
+	return drop
+}
+
+// LevelUpSupporter spends training manuals to raise an owned card's level
+func (gs *GameState) LevelUpSupporter(supporterID string) error {
+	supporter := gs.GetSupporter(supporterID)
+	if supporter == nil || !supporter.IsOwned {
+		return fmt.Errorf("supporter not owned")
+	}
+	if supporter.CardLevel() >= supporter.LevelCap() {
+		if supporter.LimitBreak < MaxLimitBreak {
+			return fmt.Errorf("%s is at the level cap; limit break with a duplicate to raise it", supporter.Name)
+		}
+		return fmt.Errorf("%s is at max level", supporter.Name)
+	}
+
+	cost := supporter.LevelUpCost()
+	if gs.TrainingManuals < cost {
+		return fmt.Errorf("not enough training manuals: need %d, have %d", cost, gs.TrainingManuals)
+	}
+
+	gs.TrainingManuals -= cost
+	supporter.Level = supporter.CardLevel() + 1
+	return nil
+}
+
+// RaceMaterials returns the training manuals a race finish earns, by grade and position
+func RaceMaterials(grade RaceGrade, position int) int {
+	base := map[RaceGrade]int{
+		MaidenRace: 5,
+		Grade3:     10,
+		Grade2:     15,
+		Grade1:     25,
+		GradeG1:    40,
+	}[grade]
+
+	switch position {
+	case 1:
+		return base
+	case 2:
+		return base * 3 / 4
+	case 3:
+		return base / 2
+	default:
+		return base / 4
+	}
+}
+
+// AwardRaceMaterials adds the training manuals for a race finish and returns the amount
+func (gs *GameState) AwardRaceMaterials(grade RaceGrade, position int) int {
+	materials := RaceMaterials(grade, position)
+	gs.TrainingManuals += materials
+	return materials
+}
@@ -0,0 +1,132 @@
+package models
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+)
+
+// CommentaryCues are the race situations a commentary pack can write lines for
+var CommentaryCues = []string{
+	"preamble", "gates", "draw", "slow_start", "reared", "refused", "away",
+	"off", "early_leader", "halfway", "home_stretch",
+	"lead_change", "move", "player_surge", "fading", "bunched", "clear",
+	"close_finish", "winner",
+}
+
+// DefaultCommentary is the pack used until the player picks another
+const DefaultCommentary = "en-classic"
+
+// CommentaryPack is a set of commentary templates in one tone and language, loaded from the data files
+type CommentaryPack struct {
+	ID     string              `json:"id"`
+	Name   string              `json:"name"`
+	Locale string              `json:"locale"`
+	Tone   string              `json:"tone"`
+	Lines  map[string][]string `json:"lines"` // Cue -> templates; {horse}, {other} and the like are filled in
+}
+
+// Validate checks the pack only writes lines for cues the game understands
+func (p CommentaryPack) Validate() error {
+	if p.ID == "" {
+		return fmt.Errorf("commentary pack is missing an id")
+	}
+	for cue, lines := range p.Lines {
+		known := false
+		for _, name := range CommentaryCues {
+			if cue == name {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return fmt.Errorf("commentary pack %s: unknown cue %q", p.ID, cue)
+		}
+		if len(lines) == 0 {
+			return fmt.Errorf("commentary pack %s: no lines for %s", p.ID, cue)
+		}
+	}
+	return nil
+}
+
+// CommentaryLibrary maps pack IDs to commentary packs
+type CommentaryLibrary map[string]CommentaryPack
+
+// IDs returns the pack IDs in name order
+func (l CommentaryLibrary) IDs() []string {
+	ids := make([]string, 0, len(l))
+	for id := range l {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// ActiveCommentary returns the commentary pack the player picked, falling back to the default
+func (gs *GameState) ActiveCommentary() CommentaryPack {
+	if pack, ok := gs.Commentary[gs.CommentaryPack]; ok {
+		return pack
+	}
+	if pack, ok := gs.Commentary[DefaultCommentary]; ok {
+		return pack
+	}
+	for _, id := range gs.Commentary.IDs() {
+		return gs.Commentary[id]
+	}
+	return CommentaryPack{}
+}
+
+// NextCommentary switches to the next commentary pack and returns it
+func (gs *GameState) NextCommentary() CommentaryPack {
+	ids := gs.Commentary.IDs()
+	if len(ids) == 0 {
+		return CommentaryPack{}
+	}
+
+	current := gs.ActiveCommentary().ID
+	next := ids[0]
+	for i, id := range ids {
+		if id == current {
+			next = ids[(i+1)%len(ids)]
+			break
+		}
+	}
+	gs.CommentaryPack = next
+	return gs.Commentary[next]
+}
+
+// Commentator fills in a pack's templates, avoiding the same line twice in a row for a cue
+type Commentator struct {
+	pack CommentaryPack
+	last map[string]int
+}
+
+func NewCommentator(pack CommentaryPack) *Commentator {
+	return &Commentator{
+		pack: pack,
+		last: make(map[string]int),
+	}
+}
+
+// Say picks a line for the cue and fills in its placeholders from name/value pairs,
+// e.g. Say("lead_change", "horse", "Comet", "other", "Blaze"). It returns "" if the pack
+// has no lines for the cue.
+func (c *Commentator) Say(cue string, vars ...string) string {
+	lines := c.pack.Lines[cue]
+	if len(lines) == 0 {
+		return ""
+	}
+
+	pick := rand.Intn(len(lines))
+	if last, ok := c.last[cue]; ok && len(lines) > 1 && pick == last {
+		pick = (pick + 1) % len(lines)
+	}
+	c.last[cue] = pick
+
+	replacements := make([]string, 0, len(vars))
+	for i := 0; i+1 < len(vars); i += 2 {
+		replacements = append(replacements, "{"+vars[i]+"}", vars[i+1])
+	}
+	return strings.NewReplacer(replacements...).Replace(lines[pick])
+}
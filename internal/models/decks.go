@@ -0,0 +1,222 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MaxDeckSize is how many supporters can be active at once
+const MaxDeckSize = 4
+
+// MaxDeckPresets is how many named decks can be saved
+const MaxDeckPresets = 8
+
+// DeckPreset is a named set of supporters that can be swapped in before training
+type DeckPreset struct {
+	Name         string   `json:"name"`
+	SupporterIDs []string `json:"supporter_ids"`
+}
+
+// Synergy is a bonus a deck earns for its combination of supporters
+type Synergy struct {
+	Name        string
+	Description string
+	Bonus       map[TrainingType]int
+}
+
+// IsMentor reports whether the supporter is a retired horse mentoring in training
+func (s Supporter) IsMentor() bool {
+	return s.SpecialEffect == "mentor"
+}
+
+// Specialty returns the stat the supporter boosts most; ties go to the earlier stat
+func (s Supporter) Specialty() TrainingType {
+	best, bestBonus := StaminaTraining, 0
+	for _, trainingType := range deckStats {
+		if s.TrainingBonus[trainingType] > bestBonus {
+			best, bestBonus = trainingType, s.TrainingBonus[trainingType]
+		}
+	}
+	return best
+}
+
+// deckStats are the stats supporters specialise in
+var deckStats = []TrainingType{StaminaTraining, SpeedTraining, TechniqueTraining, MentalTraining}
+
+// allStats gives every stat the same bonus
+func allStats(bonus int) map[TrainingType]int {
+	bonuses := make(map[TrainingType]int)
+	for _, stat := range deckStats {
+		bonuses[stat] = bonus
+	}
+	return bonuses
+}
+
+// DeckSynergies returns the synergies a deck earns. Mentors train alongside the deck
+// but don't count toward synergies.
+func DeckSynergies(supporters []Supporter) []Synergy {
+	var deck []Supporter
+	for _, supporter := range supporters {
+		if !supporter.IsMentor() {
+			deck = append(deck, supporter)
+		}
+	}
+
+	var synergies []Synergy
+
+	// Specialists: two or three supporters whose strongest stat is the same
+	specialists := make(map[TrainingType]int)
+	for _, supporter := range deck {
+		if specialty := supporter.Specialty(); supporter.TrainingBonus[specialty] > 0 {
+			specialists[specialty]++
+		}
+	}
+	for _, trainingType := range deckStats {
+		switch count := specialists[trainingType]; {
+		case count >= 3:
+			synergies = append(synergies, Synergy{
+				Name:        trainingType.String() + " Trio",
+				Description: fmt.Sprintf("3 %s specialists", strings.ToLower(trainingType.String())),
+				Bonus:       map[TrainingType]int{trainingType: 6},
+			})
+		case count == 2:
+			synergies = append(synergies, Synergy{
+				Name:        trainingType.String() + " Duo",
+				Description: fmt.Sprintf("2 %s specialists", strings.ToLower(trainingType.String())),
+				Bonus:       map[TrainingType]int{trainingType: 3},
+			})
+		}
+	}
+
+	// Full Spectrum: one card of every rarity
+	rarities := make(map[Rarity]bool)
+	for _, supporter := range deck {
+		rarities[supporter.Rarity] = true
+	}
+	if len(rarities) == 4 {
+		synergies = append(synergies, Synergy{
+			Name:        "Full Spectrum",
+			Description: "one card of every rarity",
+			Bonus:       allStats(2),
+		})
+	}
+
+	// Well Rounded: a specialist for every stat
+	if len(specialists) == 4 {
+		synergies = append(synergies, Synergy{
+			Name:        "Well Rounded",
+			Description: "a specialist for every stat",
+			Bonus:       allStats(1),
+		})
+	}
+
+	return synergies
+}
+
+// SynergyBonus totals the deck's synergy bonuses for a training type
+func SynergyBonus(supporters []Supporter, trainingType TrainingType) int {
+	bonus := 0
+	for _, synergy := range DeckSynergies(supporters) {
+		bonus += synergy.Bonus[trainingType.Stat()]
+	}
+	return bonus
+}
+
+// SaveDeckPreset saves the active supporters as a named preset, replacing one with the same name
+func (gs *GameState) SaveDeckPreset(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return fmt.Errorf("deck needs a name")
+	}
+	if len(gs.ActiveSupporters) == 0 {
+		return fmt.Errorf("no supporters selected")
+	}
+
+	preset := DeckPreset{Name: name, SupporterIDs: append([]string(nil), gs.ActiveSupporters...)}
+	for i, existing := range gs.DeckPresets {
+		if strings.EqualFold(existing.Name, name) {
+			gs.DeckPresets[i] = preset
+			return nil
+		}
+	}
+	if len(gs.DeckPresets) >= MaxDeckPresets {
+		return fmt.Errorf("deck preset limit reached (%d)", MaxDeckPresets)
+	}
+	gs.DeckPresets = append(gs.DeckPresets, preset)
+	return nil
+}
+
+// LoadDeckPreset makes a preset the active deck. Supporters that can no longer be selected are skipped.
+func (gs *GameState) LoadDeckPreset(name string) error {
+	for _, preset := range gs.DeckPresets {
+		if preset.Name != name {
+			continue
+		}
+
+		previous := gs.ActiveSupporters
+		gs.ActiveSupporters = make([]string, 0, MaxDeckSize)
+		for _, supporterID := range preset.SupporterIDs {
+			gs.SelectSupporter(supporterID)
+		}
+		if len(gs.ActiveSupporters) == 0 {
+			gs.ActiveSupporters = previous
+			return fmt.Errorf("none of the supporters in %s can be selected", name)
+		}
+		return nil
+	}
+	return fmt.Errorf("deck preset not found")
+}
+
+// DeleteDeckPreset removes a saved preset
+func (gs *GameState) DeleteDeckPreset(name string) error {
+	for i, preset := range gs.DeckPresets {
+		if preset.Name == name {
+			gs.DeckPresets = append(gs.DeckPresets[:i], gs.DeckPresets[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("deck preset not found")
+}
+
+// ActiveDeckPreset returns the name of the preset matching the active deck, if any
+func (gs *GameState) ActiveDeckPreset() string {
+	for _, preset := range gs.DeckPresets {
+		if sameSupporters(preset.SupporterIDs, gs.ActiveSupporters) {
+			return preset.Name
+		}
+	}
+	return ""
+}
+
+// NextDeckPreset loads the preset after the active one, wrapping around
+func (gs *GameState) NextDeckPreset() (string, error) {
+	if len(gs.DeckPresets) == 0 {
+		return "", fmt.Errorf("no deck presets saved")
+	}
+
+	next := 0
+	current := gs.ActiveDeckPreset()
+	for i, preset := range gs.DeckPresets {
+		if preset.Name == current {
+			next = (i + 1) % len(gs.DeckPresets)
+		}
+	}
+	name := gs.DeckPresets[next].Name
+	return name, gs.LoadDeckPreset(name)
+}
+
+func sameSupporters(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	seen := make(map[string]bool)
+	for _, id := range a {
+		seen[id] = true
+	}
+	for _, id := range b {
+		if !seen[id] {
+			return false
+		}
+	}
+	return true
+}
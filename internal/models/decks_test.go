@@ -0,0 +1,49 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDeckSynergies(t *testing.T) {
+	card := func(rarity Rarity, specialty TrainingType) Supporter {
+		return Supporter{Rarity: rarity, TrainingBonus: map[TrainingType]int{specialty: 5}}
+	}
+	mentor := card(Common, SpeedTraining)
+	mentor.SpecialEffect = "mentor"
+
+	tests := []struct {
+		name      string
+		deck      []Supporter
+		want      []string
+		bonusType TrainingType
+		wantBonus int
+	}{
+		{"no synergy for unrelated cards", []Supporter{card(Common, SpeedTraining), card(Common, StaminaTraining)}, nil, SpeedTraining, 0},
+		{"two specialists make a duo", []Supporter{card(Common, SpeedTraining), card(Rare, SpeedTraining)}, []string{"Speed Duo"}, SpeedTraining, 3},
+		{"three specialists make a trio", []Supporter{card(Common, StaminaTraining), card(Rare, StaminaTraining), card(Rare, StaminaTraining)}, []string{"Stamina Trio"}, StaminaTraining, 6},
+		{"a duo only boosts its own stat", []Supporter{card(Common, SpeedTraining), card(Rare, SpeedTraining)}, []string{"Speed Duo"}, MentalTraining, 0},
+		{
+			"every rarity and every specialty",
+			[]Supporter{card(Common, StaminaTraining), card(Rare, SpeedTraining), card(SuperRare, TechniqueTraining), card(UltraRare, MentalTraining)},
+			[]string{"Full Spectrum", "Well Rounded"}, TechniqueTraining, 3,
+		},
+		{"mentors don't count toward synergies", []Supporter{card(Common, SpeedTraining), mentor}, nil, SpeedTraining, 0},
+		{"cards with no bonus aren't specialists", []Supporter{{Rarity: Common}, {Rarity: Rare}}, nil, StaminaTraining, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var names []string
+			for _, synergy := range DeckSynergies(tt.deck) {
+				names = append(names, synergy.Name)
+			}
+			if !reflect.DeepEqual(names, tt.want) {
+				t.Errorf("DeckSynergies() = %v, want %v", names, tt.want)
+			}
+			if bonus := SynergyBonus(tt.deck, tt.bonusType); bonus != tt.wantBonus {
+				t.Errorf("SynergyBonus(%s) = %d, want %d", tt.bonusType.String(), bonus, tt.wantBonus)
+			}
+		})
+	}
+}
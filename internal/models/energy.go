@@ -0,0 +1,121 @@
+package models
+
+import "math"
+
+const (
+	// CruiseEffort is the share of top speed a horse can hold on aerobic energy alone;
+	// running any harder draws on the sprint reserve
+	CruiseEffort = 0.85
+	// KickEffort is the hardest a horse can run, flat out in its finishing kick
+	KickEffort = 1.1
+	// TiredEffort is the most a horse with its aerobic reserve spent can manage
+	TiredEffort = 0.6
+	// DraftGap is how close, as a share of the leader's distance, a horse must sit behind another to draft
+	DraftGap = 0.03
+	// WhipCost is the sprint energy a crack of the whip asks for
+	WhipCost = 20
+)
+
+const (
+	cruiseCost    = 20.0 // Energy spent per turn at cruising effort; the cost rises with the cube of effort
+	draftSaving   = 0.3  // Share of the cost saved sitting in behind another horse
+	draftRecovery = 3    // Sprint energy recovered per turn while drafting
+	easeRecovery  = 1    // Sprint energy recovered per turn below cruising effort in clear air
+)
+
+// Energy is a horse's reserves during a race: an aerobic reserve, from Stamina, that keeps
+// it galloping and a sprint reserve, from Speed and Mental, for surges and the finishing kick
+type Energy struct {
+	Aerobic    int `json:"aerobic"`
+	Sprint     int `json:"sprint"`
+	MaxAerobic int `json:"max_aerobic"`
+	MaxSprint  int `json:"max_sprint"`
+}
+
+// EnergyMap maps horse IDs to their reserves
+type EnergyMap map[string]Energy
+
+// NewEnergy fills a horse's reserves for the start of a race
+func NewEnergy(h *Horse) Energy {
+	aerobic := 240 + max(h.Stamina, 0)
+	sprint := 20 + max(h.Speed, 0)/5 + max(h.Mental, 0)/10
+	return Energy{
+		Aerobic:    aerobic,
+		Sprint:     sprint,
+		MaxAerobic: aerobic,
+		MaxSprint:  sprint,
+	}
+}
+
+// AerobicRatio returns the share of the aerobic reserve left
+func (e Energy) AerobicRatio() float64 {
+	if e.MaxAerobic <= 0 {
+		return 0
+	}
+	return float64(e.Aerobic) / float64(e.MaxAerobic)
+}
+
+// SprintRatio returns the share of the sprint reserve left
+func (e Energy) SprintRatio() float64 {
+	if e.MaxSprint <= 0 {
+		return 0
+	}
+	return float64(e.Sprint) / float64(e.MaxSprint)
+}
+
+// Spent reports whether the horse has run through its aerobic reserve
+func (e Energy) Spent() bool {
+	return e.Aerobic <= 0
+}
+
+// Run spends a turn's energy at the effort asked for and returns the effort the horse
+// could actually make. Running costs steeply more the harder the horse goes: cruising comes
+// out of the aerobic reserve, and anything above that out of the sprint reserve first. A horse
+// out of aerobic energy can only manage TiredEffort. Drafting saves energy and restores sprint energy.
+func (e *Energy) Run(effort float64, drafting bool) float64 {
+	if e.Spent() {
+		return math.Min(effort, TiredEffort)
+	}
+
+	// Ease off to what's left in the tank
+	effort = math.Min(effort, CruiseEffort*math.Cbrt(float64(e.Aerobic+e.Sprint)/runCost(1, drafting)))
+
+	relative := effort / CruiseEffort
+	cost := runCost(relative, drafting)
+	if surge := cost - runCost(1, drafting); surge > 0 {
+		fromSprint := math.Min(surge, float64(e.Sprint))
+		e.Sprint -= int(math.Ceil(fromSprint))
+		cost -= fromSprint
+	}
+	e.Aerobic -= int(math.Round(cost))
+
+	switch {
+	case drafting:
+		e.Sprint += draftRecovery
+	case effort < CruiseEffort:
+		e.Sprint += easeRecovery
+	}
+
+	e.Aerobic = max(e.Aerobic, 0)
+	e.Sprint = min(max(e.Sprint, 0), e.MaxSprint)
+	return effort
+}
+
+// Spend takes sprint energy for an effort outside the horse's own pacing, such as answering
+// the whip, and reports whether there was enough left
+func (e *Energy) Spend(cost int) bool {
+	if e.Sprint < cost {
+		return false
+	}
+	e.Sprint -= cost
+	return true
+}
+
+// runCost is the energy a turn costs at the given share of cruising effort
+func runCost(relative float64, drafting bool) float64 {
+	cost := cruiseCost * relative * relative * relative
+	if drafting {
+		cost *= 1 - draftSaving
+	}
+	return cost
+}
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEnergyRun(t *testing.T) {
+	full := Energy{Aerobic: 300, Sprint: 30, MaxAerobic: 300, MaxSprint: 40}
+
+	tests := []struct {
+		name        string
+		start       Energy
+		effort      float64
+		drafting    bool
+		wantEffort  float64
+		wantAerobic int
+		wantSprint  int
+	}{
+		{"cruising spends only aerobic energy", full, CruiseEffort, false, CruiseEffort, 280, 30},
+		{"drafting saves energy and restores sprint", full, CruiseEffort, true, CruiseEffort, 286, 33},
+		{"kicking draws the surge from the sprint reserve", full, KickEffort, false, KickEffort, 280, 6},
+		{"easing off recovers sprint energy", full, 0.6, false, 0.6, 293, 31},
+		{"sprint recovery stops at the maximum", Energy{Aerobic: 300, Sprint: 40, MaxAerobic: 300, MaxSprint: 40}, CruiseEffort, true, CruiseEffort, 286, 40},
+		{"an empty sprint reserve leaves the surge to aerobic energy", Energy{Aerobic: 300, Sprint: 0, MaxAerobic: 300, MaxSprint: 40}, KickEffort, false, KickEffort, 257, 0},
+		{"a spent horse can only manage tired effort", Energy{Aerobic: 0, Sprint: 40, MaxAerobic: 300, MaxSprint: 40}, KickEffort, false, TiredEffort, 0, 40},
+		{"a nearly empty tank eases the effort to what's left", Energy{Aerobic: 5, Sprint: 0, MaxAerobic: 300, MaxSprint: 40}, CruiseEffort, false, CruiseEffort * math.Cbrt(0.25), 0, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			energy := tt.start
+			effort := energy.Run(tt.effort, tt.drafting)
+			if math.Abs(effort-tt.wantEffort) > 1e-9 {
+				t.Errorf("effort = %v, want %v", effort, tt.wantEffort)
+			}
+			if energy.Aerobic != tt.wantAerobic || energy.Sprint != tt.wantSprint {
+				t.Errorf("reserves = %d aerobic, %d sprint, want %d, %d", energy.Aerobic, energy.Sprint, tt.wantAerobic, tt.wantSprint)
+			}
+		})
+	}
+}
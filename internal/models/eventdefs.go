@@ -0,0 +1,377 @@
+package models
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// EventDefinition is a data-driven event with trigger conditions, loaded from event files
+type EventDefinition struct {
+	ID          string          `json:"id"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Trigger     string          `json:"trigger"`  // "training", "race" or "season"
+	Weight      float64         `json:"weight"`   // Relative chance among eligible events
+	Cooldown    int             `json:"cooldown"` // Weeks before the event can happen again to the same horse
+	Once        bool            `json:"once"`     // Only ever happens once per horse
+	Conditions  EventConditions `json:"conditions"`
+	Effects     map[string]int  `json:"effects,omitempty"` // Applied immediately when there are no choices
+	Choices     []EventChoice   `json:"choices,omitempty"`
+	Skill       string          `json:"skill,omitempty"` // Skill taught when there are no choices
+	Hint        string          `json:"hint,omitempty"`  // Skill hinted at when there are no choices
+}
+
+// EventConditions restricts when an event can trigger
+type EventConditions struct {
+	Min             map[string]int    `json:"min,omitempty"`              // Condition key -> minimum value
+	Max             map[string]int    `json:"max,omitempty"`              // Condition key -> maximum value
+	TrainingTypes   []string          `json:"training_types,omitempty"`   // Only during these training types
+	OwnedSupporters []string          `json:"owned_supporters,omitempty"` // Supporter IDs that must be owned
+	Helpers         []string          `json:"helpers,omitempty"`          // Supporter IDs that must be in the deck helping the horse
+	Bonds           map[string]int    `json:"bonds,omitempty"`            // Supporter ID -> minimum bond
+	RequiresEvents  []string          `json:"requires_events,omitempty"`  // Events the horse must have had
+	RequiresChoices map[string]string `json:"requires_choices,omitempty"` // Event ID -> choice the horse must have taken
+	ExcludesEvents  []string          `json:"excludes_events,omitempty"`  // Events the horse must not have had
+}
+
+// EventEffectKeys are the stats event effects can change
+var EventEffectKeys = []string{"morale", "fatigue", "fan_support", "stamina", "speed", "technique", "mental"}
+
+// EventConditionKeys are the values min/max conditions can check
+var EventConditionKeys = []string{"stamina", "speed", "technique", "mental", "fatigue", "morale",
+	"fan_support", "losing_streak", "age", "wins", "races", "rating", "week", "season"}
+
+// eventChances is the chance that an event fires at each trigger point
+var eventChances = map[EventType]float64{
+	TrainingEvent: 0.15,
+	RaceEvent:     0.25,
+	SeasonEvent:   0.20,
+}
+
+// EventType converts the definition's trigger name
+func (d EventDefinition) EventType() (EventType, error) {
+	switch d.Trigger {
+	case "training":
+		return TrainingEvent, nil
+	case "race":
+		return RaceEvent, nil
+	case "season":
+		return SeasonEvent, nil
+	default:
+		return 0, fmt.Errorf("event %s: unknown trigger %q", d.ID, d.Trigger)
+	}
+}
+
+// Validate checks the definition only uses triggers, conditions and effects the game understands
+func (d EventDefinition) Validate() error {
+	if d.ID == "" {
+		return fmt.Errorf("event is missing an id")
+	}
+	if _, err := d.EventType(); err != nil {
+		return err
+	}
+	if d.Weight < 0 || d.Cooldown < 0 {
+		return fmt.Errorf("event %s: weight and cooldown must not be negative", d.ID)
+	}
+	if len(d.Effects) == 0 && len(d.Choices) == 0 {
+		return fmt.Errorf("event %s: needs effects or choices", d.ID)
+	}
+
+	if err := validateKeys(d.ID, "effect", d.Effects, EventEffectKeys); err != nil {
+		return err
+	}
+	if err := validateSkill(d.ID, d.Skill); err != nil {
+		return err
+	}
+	if err := validateSkill(d.ID, d.Hint); err != nil {
+		return err
+	}
+	for _, choice := range d.Choices {
+		if choice.Text == "" {
+			return fmt.Errorf("event %s: choice is missing text", d.ID)
+		}
+		if err := validateKeys(d.ID, "effect", choice.Effects, EventEffectKeys); err != nil {
+			return err
+		}
+		if err := validateSkill(d.ID, choice.Skill); err != nil {
+			return err
+		}
+		if err := validateSkill(d.ID, choice.Hint); err != nil {
+			return err
+		}
+	}
+
+	if err := validateKeys(d.ID, "condition", d.Conditions.Min, EventConditionKeys); err != nil {
+		return err
+	}
+	if err := validateKeys(d.ID, "condition", d.Conditions.Max, EventConditionKeys); err != nil {
+		return err
+	}
+	for _, name := range d.Conditions.TrainingTypes {
+		if _, ok := ParseTrainingType(name); !ok {
+			return fmt.Errorf("event %s: unknown training type %q", d.ID, name)
+		}
+	}
+	for supporterID, bond := range d.Conditions.Bonds {
+		if bond < 0 || bond > MaxBond {
+			return fmt.Errorf("event %s: bond for %s must be between 0 and %d", d.ID, supporterID, MaxBond)
+		}
+	}
+
+	return nil
+}
+
+func validateSkill(eventID, skillID string) error {
+	if skillID == "" {
+		return nil
+	}
+	if _, ok := GetSkill(skillID); !ok {
+		return fmt.Errorf("event %s: unknown skill %q", eventID, skillID)
+	}
+	return nil
+}
+
+func validateKeys(eventID, kind string, values map[string]int, allowed []string) error {
+	for key := range values {
+		found := false
+		for _, name := range allowed {
+			if key == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("event %s: unknown %s key %q", eventID, kind, key)
+		}
+	}
+	return nil
+}
+
+// ToEvent builds a playable event from the definition
+func (d EventDefinition) ToEvent() Event {
+	eventType, _ := d.EventType()
+	return Event{
+		ID:          d.ID,
+		Name:        d.Name,
+		Description: d.Description,
+		Type:        eventType,
+		Choices:     d.Choices,
+		Effects:     d.Effects,
+		Skill:       d.Skill,
+		Hint:        d.Hint,
+	}
+}
+
+// EventLibrary holds the event definitions available to the game
+type EventLibrary struct {
+	definitions []EventDefinition
+}
+
+func NewEventLibrary(definitions []EventDefinition) *EventLibrary {
+	return &EventLibrary{definitions: definitions}
+}
+
+// Definitions returns all loaded event definitions
+func (lib *EventLibrary) Definitions() []EventDefinition {
+	return lib.definitions
+}
+
+// ParseTrainingType converts a training type name such as "Speed" to its TrainingType
+func ParseTrainingType(name string) (TrainingType, bool) {
+	for _, trainingType := range AllTrainingTypes {
+		if trainingType.String() == name {
+			return trainingType, true
+		}
+	}
+	return 0, false
+}
+
+// rollEvent may pick an eligible event for the horse at a trigger point. supporters are the
+// cards helping the horse, checked by helpers conditions.
+func (gs *GameState) rollEvent(trigger EventType, horse *Horse, trainingType *TrainingType, supporters []Supporter) *Event {
+	if gs.EventLibrary == nil || horse == nil {
+		return nil
+	}
+	if rand.Float64() > eventChances[trigger] {
+		return nil
+	}
+
+	var eligible []EventDefinition
+	totalWeight := 0.0
+	for _, def := range gs.EventLibrary.definitions {
+		eventType, err := def.EventType()
+		if err != nil || eventType != trigger || def.Weight <= 0 {
+			continue
+		}
+		if !gs.eventConditionsMet(def, horse, trainingType, supporters) {
+			continue
+		}
+		eligible = append(eligible, def)
+		totalWeight += def.Weight
+	}
+
+	if len(eligible) == 0 {
+		return nil
+	}
+
+	pick := rand.Float64() * totalWeight
+	for _, def := range eligible {
+		pick -= def.Weight
+		if pick <= 0 {
+			event := def.ToEvent()
+			return &event
+		}
+	}
+	event := eligible[len(eligible)-1].ToEvent()
+	return &event
+}
+
+// eventConditionsMet checks a definition's conditions, cooldown and chain requirements for a horse
+func (gs *GameState) eventConditionsMet(def EventDefinition, horse *Horse, trainingType *TrainingType, supporters []Supporter) bool {
+	conditions := def.Conditions
+
+	for key, minimum := range conditions.Min {
+		if gs.eventConditionValue(key, horse) < minimum {
+			return false
+		}
+	}
+	for key, maximum := range conditions.Max {
+		if gs.eventConditionValue(key, horse) > maximum {
+			return false
+		}
+	}
+
+	if len(conditions.TrainingTypes) > 0 {
+		if trainingType == nil {
+			return false
+		}
+		matched := false
+		for _, name := range conditions.TrainingTypes {
+			if parsed, ok := ParseTrainingType(name); ok && parsed == *trainingType {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	for _, supporterID := range conditions.OwnedSupporters {
+		owned := false
+		for _, supporter := range gs.Supporters {
+			if supporter.ID == supporterID && supporter.IsOwned {
+				owned = true
+				break
+			}
+		}
+		if !owned {
+			return false
+		}
+	}
+
+	for _, supporterID := range conditions.Helpers {
+		active := false
+		for _, supporter := range supporters {
+			if supporter.ID == supporterID {
+				active = true
+				break
+			}
+		}
+		if !active {
+			return false
+		}
+	}
+
+	for supporterID, minimum := range conditions.Bonds {
+		supporter := gs.GetSupporter(supporterID)
+		if supporter == nil || !supporter.IsOwned || supporter.Bond < minimum {
+			return false
+		}
+	}
+
+	for _, eventID := range conditions.RequiresEvents {
+		if horse.lastEventRecord(eventID) == nil {
+			return false
+		}
+	}
+	for eventID, choice := range conditions.RequiresChoices {
+		if !horse.hasChosen(eventID, choice) {
+			return false
+		}
+	}
+	for _, eventID := range conditions.ExcludesEvents {
+		if horse.lastEventRecord(eventID) != nil {
+			return false
+		}
+	}
+
+	last := horse.lastEventRecord(def.ID)
+	if last != nil {
+		if def.Once {
+			return false
+		}
+		weeksSince := (gs.Season.Number-last.Season)*max(gs.Season.MaxWeeks, 1) + gs.Season.CurrentWeek - last.Week
+		if weeksSince < def.Cooldown {
+			return false
+		}
+	}
+
+	return true
+}
+
+// eventConditionValue looks up the value a min/max condition compares against
+func (gs *GameState) eventConditionValue(key string, horse *Horse) int {
+	switch key {
+	case "stamina":
+		return horse.Stamina
+	case "speed":
+		return horse.Speed
+	case "technique":
+		return horse.Technique
+	case "mental":
+		return horse.Mental
+	case "fatigue":
+		return horse.Fatigue
+	case "morale":
+		return horse.Morale
+	case "fan_support":
+		return horse.FanSupport
+	case "losing_streak":
+		return horse.LosingStreak
+	case "age":
+		return horse.Age
+	case "wins":
+		return horse.Wins
+	case "races":
+		return horse.Races
+	case "rating":
+		return horse.GetOverallRating()
+	case "week":
+		return gs.Season.CurrentWeek
+	case "season":
+		return gs.Season.Number
+	default:
+		return 0
+	}
+}
+
+// lastEventRecord returns the horse's most recent record of an event
+func (h *Horse) lastEventRecord(eventID string) *EventRecord {
+	for i := len(h.EventHistory) - 1; i >= 0; i-- {
+		if h.EventHistory[i].EventID == eventID {
+			return &h.EventHistory[i]
+		}
+	}
+	return nil
+}
+
+// hasChosen checks if the horse's trainer ever took a specific choice in an event
+func (h *Horse) hasChosen(eventID, choice string) bool {
+	for _, record := range h.EventHistory {
+		if record.EventID == eventID && record.Choice == choice {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,204 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EventRecord is an event that happened to a horse, with the trainer's decision if it had choices
+type EventRecord struct {
+	EventID   string         `json:"event_id"`
+	EventName string         `json:"event_name"`
+	Type      EventType      `json:"type"`
+	Choice    string         `json:"choice"`
+	Effects   map[string]int `json:"effects"`
+	Season    int            `json:"season"`
+	Week      int            `json:"week"`
+}
+
+func (t EventType) String() string {
+	switch t {
+	case TrainingEvent:
+		return "Training"
+	case RaceEvent:
+		return "Race"
+	case SeasonEvent:
+		return "Season"
+	default:
+		return "Unknown"
+	}
+}
+
+// HasChoices reports whether the event needs a decision from the player
+func (e Event) HasChoices() bool {
+	return len(e.Choices) > 0
+}
+
+// ResolveEvent applies the chosen option's effects and records the decision in the horse's history
+func (gs *GameState) ResolveEvent(h *Horse, event Event, choiceIndex int) (EventChoice, error) {
+	if choiceIndex < 0 || choiceIndex >= len(event.Choices) {
+		return EventChoice{}, fmt.Errorf("invalid choice for event %s", event.ID)
+	}
+
+	choice := event.Choices[choiceIndex]
+	gs.applyEventEffects(h, choice.Effects)
+	if choice.Skill != "" {
+		h.LearnSkill(choice.Skill)
+	}
+	if choice.Hint != "" {
+		h.AddSkillHint(choice.Hint)
+	}
+	h.recordEvent(event, choice.Text, choice.Effects, gs.Season.Number, gs.Season.CurrentWeek)
+
+	return choice, nil
+}
+
+// ResolvePendingEvent applies the player's decision on the event waiting for one
+func (gs *GameState) ResolvePendingEvent(choiceIndex int) (EventChoice, error) {
+	if gs.PendingEvent == nil {
+		return EventChoice{}, fmt.Errorf("no event waiting for a decision")
+	}
+
+	horse := gs.GetStableHorse(gs.PendingEventHorseID)
+	if horse == nil {
+		// The horse left the stable before the decision was made
+		gs.PendingEvent = nil
+		gs.PendingEventHorseID = ""
+		return EventChoice{}, fmt.Errorf("horse no longer in stable")
+	}
+
+	choice, err := gs.ResolveEvent(horse, *gs.PendingEvent, choiceIndex)
+	if err != nil {
+		return EventChoice{}, err
+	}
+
+	gs.PendingEvent = nil
+	gs.PendingEventHorseID = ""
+	return choice, nil
+}
+
+// TrainHorse runs a training session with the stable's facilities and grows supporter bonds.
+// The session may injure the horse or trigger a training event; events with choices are
+// returned unresolved and held as the pending event for the player to decide
+func (gs *GameState) TrainHorse(horse *Horse, trainingType TrainingType, supporters []Supporter) TrainingResult {
+	facilities := gs.FacilityBonus(trainingType)
+	result := horse.Train(trainingType, supporters, facilities)
+	if !result.Success {
+		return result
+	}
+
+	if partners := FriendshipPartners(supporters, trainingType); len(partners) >= FriendshipSupporters {
+		for _, partner := range partners {
+			result.Friendship = append(result.Friendship, partner.Name)
+		}
+		result.addMessage(fmt.Sprintf("🤝 Friendship training with %s!", strings.Join(result.Friendship, " & ")))
+	}
+	result.BondLevelUps = gs.growBonds(supporters, trainingType)
+
+	if horse.rollInjury(trainingType, facilities) {
+		result.Injured = true
+		result.addMessage(fmt.Sprintf("%s picked up a knock during training!", horse.Name))
+	}
+
+	if skill, learned := horse.CheckTrainingSkill(trainingType); learned {
+		result.LearnedSkill = skill.Name
+	}
+
+	if event := gs.rollEvent(TrainingEvent, horse, &trainingType, supporters); event != nil {
+		result.Event = event
+		result.addMessage(event.Description)
+		gs.applyAutomaticEvent(horse, *event)
+		gs.holdEvent(horse, event)
+		if event.Hint != "" && !event.HasChoices() {
+			result.SkillHint = horse.SkillHintStatus(event.Hint)
+		}
+	}
+	return result
+}
+
+// addMessage adds a line to the session's message, after anything already reported
+func (r *TrainingResult) addMessage(message string) {
+	if r.Message == "" {
+		r.Message = message
+		return
+	}
+	r.Message += "\n" + message
+}
+
+// RollRaceEvent may trigger a pre-race event; events with choices are returned unresolved
+// and held as the pending event
+func (gs *GameState) RollRaceEvent(horse *Horse) *Event {
+	event := gs.rollEvent(RaceEvent, horse, nil, gs.GetActiveSupporters())
+	if event != nil {
+		gs.applyAutomaticEvent(horse, *event)
+		gs.holdEvent(horse, event)
+	}
+	return event
+}
+
+// rollSeasonEvent may raise a season event for the active horse at the start of a week.
+// Events with choices pause the season until the player decides.
+func (gs *GameState) rollSeasonEvent() {
+	if gs.PlayerHorse == nil || gs.PendingEvent != nil {
+		return
+	}
+
+	event := gs.rollEvent(SeasonEvent, gs.PlayerHorse, nil, gs.GetActiveSupporters())
+	if event == nil {
+		return
+	}
+
+	if !event.HasChoices() {
+		gs.applyAutomaticEvent(gs.PlayerHorse, *event)
+		gs.AddNotice(event.Description)
+		return
+	}
+	gs.holdEvent(gs.PlayerHorse, event)
+}
+
+// holdEvent keeps an event with choices as the pending event until the player decides.
+// It is saved with the game, so quitting before the decision doesn't lose the event.
+func (gs *GameState) holdEvent(horse *Horse, event *Event) {
+	if !event.HasChoices() {
+		return
+	}
+	gs.PendingEvent = event
+	gs.PendingEventHorseID = horse.ID
+}
+
+// applyAutomaticEvent applies and records an event that has no choices
+func (gs *GameState) applyAutomaticEvent(horse *Horse, event Event) {
+	if event.HasChoices() {
+		return
+	}
+	gs.applyEventEffects(horse, event.Effects)
+	if event.Skill != "" {
+		horse.LearnSkill(event.Skill)
+	}
+	if event.Hint != "" {
+		horse.AddSkillHint(event.Hint)
+	}
+	horse.recordEvent(event, "", event.Effects, gs.Season.Number, gs.Season.CurrentWeek)
+}
+
+// applyEventEffects applies an event's effects to the horse, with fan support counted
+// toward the stable's fans and the horse's fan tier
+func (gs *GameState) applyEventEffects(horse *Horse, effects map[string]int) {
+	horse.applyEventEffects(effects)
+	if fans, ok := effects["fan_support"]; ok {
+		gs.AddFans(horse, fans)
+	}
+}
+
+// recordEvent adds an event to the horse's history, used for chains and cooldowns
+func (h *Horse) recordEvent(event Event, choice string, effects map[string]int, season, week int) {
+	h.EventHistory = append(h.EventHistory, EventRecord{
+		EventID:   event.ID,
+		EventName: event.Name,
+		Type:      event.Type,
+		Choice:    choice,
+		Effects:   effects,
+		Season:    season,
+		Week:      week,
+	})
+}
@@ -0,0 +1,172 @@
+package models
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// Facility is a stable building that improves training as it is upgraded
+type Facility struct {
+	ID                 string
+	Name               string
+	Icon               string
+	Description        string
+	MaxLevel           int
+	BaseCost           int            // Cost of the first level; each further level costs more
+	Boosts             []TrainingType // Core stats whose training gains rise with each level
+	GainPerLevel       int
+	FatigueCutPerLevel int     // Fatigue removed from every session per level
+	InjuryCutPerLevel  float64 // Fraction of injury risk removed per level
+	Unlocks            *TrainingType
+}
+
+// FacilityBonus is the combined effect of the stable's facilities on one training session
+type FacilityBonus struct {
+	Gain       int
+	FatigueCut int
+	InjuryCut  float64 // 0.0 to 1.0
+}
+
+// SessionFatigue returns the fatigue a session of the training type adds with this bonus
+func (b FacilityBonus) SessionFatigue(trainingType TrainingType) int {
+	return max(trainingType.BaseFatigue()-b.FatigueCut, MinTrainingFatigue)
+}
+
+func unlocks(trainingType TrainingType) *TrainingType {
+	return &trainingType
+}
+
+var facilityCatalog = []Facility{
+	{ID: "hill_track", Name: "Hill Track", Icon: "⛰️", MaxLevel: 3, BaseCost: 6000,
+		Description: "Uphill gallops build stamina", Boosts: []TrainingType{StaminaTraining}, GainPerLevel: 2},
+	{ID: "treadmill", Name: "Treadmill", Icon: "🏃", MaxLevel: 3, BaseCost: 6000,
+		Description: "Controlled speed work", Boosts: []TrainingType{SpeedTraining}, GainPerLevel: 2},
+	{ID: "starting_gate", Name: "Starting Gate", Icon: "🚪", MaxLevel: 3, BaseCost: 5000,
+		Description: "Unlocks Gate Practice and sharpens technique", Boosts: []TrainingType{TechniqueTraining},
+		GainPerLevel: 2, Unlocks: unlocks(GatePractice)},
+	{ID: "mental_room", Name: "Mental Training Room", Icon: "🧘", MaxLevel: 3, BaseCost: 5000,
+		Description: "Calm surroundings for mental training", Boosts: []TrainingType{MentalTraining}, GainPerLevel: 2},
+	{ID: "pool", Name: "Swimming Pool", Icon: "🏊", MaxLevel: 3, BaseCost: 8000,
+		Description: "Unlocks Swimming and eases fatigue from every session", FatigueCutPerLevel: 2,
+		Unlocks: unlocks(SwimmingTraining)},
+	{ID: "vet_clinic", Name: "Vet Clinic", Icon: "🩺", MaxLevel: 3, BaseCost: 7000,
+		Description: "On-site care cuts the risk of training injuries", InjuryCutPerLevel: 0.25},
+}
+
+// AllFacilities returns every facility that can be built
+func AllFacilities() []Facility {
+	return facilityCatalog
+}
+
+// GetFacility looks up a facility by ID
+func GetFacility(facilityID string) (Facility, bool) {
+	for _, facility := range facilityCatalog {
+		if facility.ID == facilityID {
+			return facility, true
+		}
+	}
+	return Facility{}, false
+}
+
+// UpgradeCost returns the price of the next level after the given one
+func (f Facility) UpgradeCost(level int) int {
+	return f.BaseCost * (level + 1)
+}
+
+// FacilityLevel returns the built level of a facility, 0 if it hasn't been built
+func (gs *GameState) FacilityLevel(facilityID string) int {
+	return gs.FacilityLevels[facilityID]
+}
+
+// UpgradeFacility builds or upgrades a facility, paying from the treasury
+func (gs *GameState) UpgradeFacility(facilityID string) error {
+	facility, ok := GetFacility(facilityID)
+	if !ok {
+		return fmt.Errorf("facility not found")
+	}
+
+	level := gs.FacilityLevel(facilityID)
+	if level >= facility.MaxLevel {
+		return fmt.Errorf("%s is already fully upgraded", facility.Name)
+	}
+
+	description := fmt.Sprintf("Built %s", facility.Name)
+	if level > 0 {
+		description = fmt.Sprintf("%s upgrade to level %d", facility.Name, level+1)
+	}
+	if err := gs.Spend(FacilityExpense, description, facility.UpgradeCost(level), ""); err != nil {
+		return err
+	}
+
+	if gs.FacilityLevels == nil {
+		gs.FacilityLevels = make(map[string]int)
+	}
+	gs.FacilityLevels[facilityID] = level + 1
+	return nil
+}
+
+// FacilityBonus totals the facility effects on a training session
+func (gs *GameState) FacilityBonus(trainingType TrainingType) FacilityBonus {
+	var bonus FacilityBonus
+	for _, facility := range facilityCatalog {
+		level := gs.FacilityLevel(facility.ID)
+		if level == 0 {
+			continue
+		}
+		for _, boosted := range facility.Boosts {
+			if boosted == trainingType.Stat() {
+				bonus.Gain += facility.GainPerLevel * level
+			}
+		}
+		bonus.FatigueCut += facility.FatigueCutPerLevel * level
+		bonus.InjuryCut += facility.InjuryCutPerLevel * float64(level)
+	}
+	bonus.InjuryCut = math.Min(bonus.InjuryCut, 1.0)
+	return bonus
+}
+
+// AvailableTrainingTypes returns the core training types plus any unlocked by facilities
+func (gs *GameState) AvailableTrainingTypes() []TrainingType {
+	types := []TrainingType{StaminaTraining, SpeedTraining, TechniqueTraining, MentalTraining}
+	for _, facility := range facilityCatalog {
+		if facility.Unlocks != nil && gs.FacilityLevel(facility.ID) > 0 {
+			types = append(types, *facility.Unlocks)
+		}
+	}
+	return types
+}
+
+// InjuryChance is the chance a session injures the horse. Risk grows with fatigue;
+// swimming is low impact and carries half the risk.
+func (h *Horse) InjuryChance(trainingType TrainingType, facilities FacilityBonus) float64 {
+	chance := 0.01
+	if h.Fatigue > 40 {
+		chance += float64(h.Fatigue-40) * 0.002
+	}
+	if trainingType == SwimmingTraining {
+		chance /= 2
+	}
+	return chance * (1 - facilities.InjuryCut)
+}
+
+// rollInjury may injure the horse after a session, costing stat points, fatigue and morale
+func (h *Horse) rollInjury(trainingType TrainingType, facilities FacilityBonus) bool {
+	if rand.Float64() >= h.InjuryChance(trainingType, facilities) {
+		return false
+	}
+
+	switch trainingType.Stat() {
+	case StaminaTraining:
+		h.Stamina = max(h.Stamina-5, 0)
+	case SpeedTraining:
+		h.Speed = max(h.Speed-5, 0)
+	case TechniqueTraining:
+		h.Technique = max(h.Technique-5, 0)
+	case MentalTraining:
+		h.Mental = max(h.Mental-5, 0)
+	}
+	h.Fatigue = min(h.Fatigue+20, 100)
+	h.Morale = max(h.Morale-15, 20)
+	return true
+}
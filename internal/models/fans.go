@@ -0,0 +1,261 @@
+package models
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// FanTier is a level of fame a horse reaches once enough fans follow it
+type FanTier struct {
+	Name              string
+	Icon              string
+	MinFans           int
+	WeeklySponsorship int     // Paid into the treasury every week
+	RetirementBonus   float64 // Multiplier on passive income after retirement
+	Unlocks           string
+}
+
+// FanTiers lists every fan tier from least to most famous
+var FanTiers = []FanTier{
+	{Name: "Unknown", Icon: "🌱", MinFans: 0, RetirementBonus: 1.0},
+	{Name: "Local Favourite", Icon: "📣", MinFans: 1000, RetirementBonus: 1.0,
+		Unlocks: "Meet-and-greets"},
+	{Name: "Rising Star", Icon: "⭐", MinFans: 5000, WeeklySponsorship: 250, RetirementBonus: 1.1,
+		Unlocks: "Weekly sponsorship, +10% retirement income"},
+	{Name: "Crowd Favourite", Icon: "🌟", MinFans: 15000, WeeklySponsorship: 600, RetirementBonus: 1.25,
+		Unlocks: "Fan Favourite Invitational, +25% retirement income"},
+	{Name: "National Icon", Icon: "👑", MinFans: 40000, WeeklySponsorship: 1500, RetirementBonus: 1.5,
+		Unlocks: "Legends Invitational, +50% retirement income"},
+}
+
+const (
+	// LosingStreakGrace is how many losses in a row fans forgive before some leave
+	LosingStreakGrace = 2
+	// MaxFanHistory is how many fan snapshots a horse keeps
+	MaxFanHistory = 96
+	// MeetAndGreetTier is the fan tier index that unlocks meet-and-greets
+	MeetAndGreetTier = 1
+	// MeetAndGreetCooldown is the number of weeks between meet-and-greets
+	MeetAndGreetCooldown = 4
+	// RivalAppearChance is the chance a rival is entered in the same race
+	RivalAppearChance = 0.5
+)
+
+// Rivalry tracks the horse that keeps beating ours to the line
+type Rivalry struct {
+	Name   string `json:"name"`
+	Wins   int    `json:"wins"`   // Races where we finished ahead of the rival
+	Losses int    `json:"losses"` // Races where the rival finished ahead
+}
+
+// FanSnapshot records a horse's fan count at a point in the season
+type FanSnapshot struct {
+	Season int `json:"season"`
+	Week   int `json:"week"`
+	Fans   int `json:"fans"`
+}
+
+// FanTierFor returns the index of the highest tier the fan count reaches
+func FanTierFor(fans int) int {
+	tier := 0
+	for i, t := range FanTiers {
+		if fans >= t.MinFans {
+			tier = i
+		}
+	}
+	return tier
+}
+
+// FanTier returns the horse's current fan tier
+func (h *Horse) FanTier() FanTier {
+	return FanTiers[FanTierFor(h.FanSupport)]
+}
+
+// NextFanTier returns the next tier up, or false when the horse is already at the top
+func (h *Horse) NextFanTier() (FanTier, bool) {
+	next := FanTierFor(h.FanSupport) + 1
+	if next >= len(FanTiers) {
+		return FanTier{}, false
+	}
+	return FanTiers[next], true
+}
+
+// AddFans changes a horse's fan count, announcing any tier it reaches, recording the new total
+// and paying any sponsor fan milestones it meets
+func (gs *GameState) AddFans(horse *Horse, fans int) {
+	before := FanTierFor(horse.FanSupport)
+	horse.FanSupport = max(horse.FanSupport+fans, 0)
+	if fans > 0 {
+		gs.GameStats.TotalFans += fans
+	}
+
+	if after := FanTierFor(horse.FanSupport); after > before {
+		tier := FanTiers[after]
+		gs.AddNotice(fmt.Sprintf("%s %s is now a %s! Unlocked: %s", tier.Icon, horse.Name, tier.Name, tier.Unlocks))
+	}
+	gs.recordFans(horse)
+
+	// Fan objectives pay out as soon as the fans arrive
+	for _, message := range gs.EvaluateContracts(horse, nil, 0) {
+		gs.AddNotice(message)
+	}
+}
+
+// recordFans stores the horse's fan count for the current week, replacing an earlier snapshot from the same week
+func (gs *GameState) recordFans(horse *Horse) {
+	snapshot := FanSnapshot{Season: gs.Season.Number, Week: gs.Season.CurrentWeek, Fans: horse.FanSupport}
+	if n := len(horse.FanHistory); n > 0 {
+		last := horse.FanHistory[n-1]
+		if last.Season == snapshot.Season && last.Week == snapshot.Week {
+			horse.FanHistory[n-1] = snapshot
+			return
+		}
+	}
+	horse.FanHistory = append(horse.FanHistory, snapshot)
+	if len(horse.FanHistory) > MaxFanHistory {
+		horse.FanHistory = horse.FanHistory[len(horse.FanHistory)-MaxFanHistory:]
+	}
+}
+
+// ReactToRace works out how fans respond to a race beyond the fans won by the finishing position.
+// Losing streaks drive fans away, while comebacks, photo finishes and rivalries draw new ones.
+// It returns the fan change and a message for each reaction.
+func ReactToRace(horse *Horse, race Race, result RaceResult) (int, []string) {
+	var fans int
+	var messages []string
+
+	// Losing streaks
+	if result.PlayerRank <= 3 {
+		horse.LosingStreak = 0
+	} else {
+		horse.LosingStreak++
+		if horse.LosingStreak > LosingStreakGrace {
+			percent := min(2*(horse.LosingStreak-LosingStreakGrace), 10)
+			if lost := horse.FanSupport * percent / 100; lost > 0 {
+				fans -= lost
+				messages = append(messages, fmt.Sprintf("💔 %d fans gave up on %s after %d losses in a row",
+					lost, horse.Name, horse.LosingStreak))
+			}
+		}
+	}
+
+	base := race.GetFansForPosition(1)
+
+	// Dramatic finishes
+	if result.PlayerRank == 1 && isComeback(result) {
+		bonus := base / 2
+		fans += bonus
+		messages = append(messages, fmt.Sprintf("🔥 What a comeback! %d new fans for %s", bonus, horse.Name))
+	}
+	if result.PlayerRank <= 2 && isPhotoFinish(result) {
+		bonus := base / 4
+		fans += bonus
+		messages = append(messages, fmt.Sprintf("📸 A photo finish! %d new fans for %s", bonus, horse.Name))
+	}
+
+	// Rivalries
+	playerPos := result.PlayerRank
+	if horse.Rival != nil {
+		for _, entrant := range result.Results {
+			if entrant.HorseName != horse.Rival.Name || entrant.HorseID == result.PlayerHorse {
+				continue
+			}
+			if playerPos < entrant.Position {
+				horse.Rival.Wins++
+				bonus := base / 3
+				fans += bonus
+				messages = append(messages, fmt.Sprintf("⚔️ %s beat rival %s! %d new fans", horse.Name, horse.Rival.Name, bonus))
+			} else {
+				horse.Rival.Losses++
+				lost := horse.FanSupport / 100
+				fans -= lost
+				messages = append(messages, fmt.Sprintf("⚔️ Rival %s finished ahead of %s (-%d fans)", horse.Rival.Name, horse.Name, lost))
+			}
+			return fans, messages
+		}
+	}
+	if playerPos == 2 && len(result.Results) > 0 && (horse.Rival == nil || horse.Rival.Name != result.Results[0].HorseName) {
+		horse.Rival = &Rivalry{Name: result.Results[0].HorseName, Losses: 1}
+		messages = append(messages, fmt.Sprintf("⚔️ Fans are calling %s the rival of %s", horse.Rival.Name, horse.Name))
+	}
+
+	return fans, messages
+}
+
+// isComeback reports whether the player won from fourth or worse going into the final quarter
+func isComeback(result RaceResult) bool {
+	if len(result.LiveProgress) == 0 {
+		return false
+	}
+	update := result.LiveProgress[len(result.LiveProgress)*3/4]
+	return update.Positions[result.PlayerHorse] >= 4
+}
+
+// isPhotoFinish reports whether the first two horses finished within 1% of each other
+func isPhotoFinish(result RaceResult) bool {
+	if len(result.Results) < 2 {
+		return false
+	}
+	winner, second := result.Results[0].Distance, result.Results[1].Distance
+	return winner > 0 && (winner-second)*100 <= winner
+}
+
+// RivalShowsUp reports whether the horse's rival is entered in the next race
+func (h *Horse) RivalShowsUp() bool {
+	return h.Rival != nil && rand.Float64() < RivalAppearChance
+}
+
+// PayFanSponsorships pays each horse's weekly sponsorship for its fan tier and records its fans for the week
+func (gs *GameState) PayFanSponsorships() {
+	for _, horse := range gs.Stable {
+		if tier := horse.FanTier(); tier.WeeklySponsorship > 0 {
+			gs.Earn(SponsorIncome, fmt.Sprintf("%s sponsorship: %s", tier.Name, horse.Name), tier.WeeklySponsorship, horse.ID)
+		}
+		gs.recordFans(horse)
+	}
+}
+
+// weekNumber counts weeks across seasons so gaps between weeks can be measured
+func (gs *GameState) weekNumber() int {
+	return (gs.Season.Number-1)*max(gs.Season.MaxWeeks, 1) + gs.Season.CurrentWeek
+}
+
+// CanMeetAndGreet reports whether the horse may hold a meet-and-greet this week
+func (gs *GameState) CanMeetAndGreet(horse *Horse) error {
+	if FanTierFor(horse.FanSupport) < MeetAndGreetTier {
+		return fmt.Errorf("%s needs %d fans for a meet-and-greet", horse.Name, FanTiers[MeetAndGreetTier].MinFans)
+	}
+	if horse.LastMeetGreet > 0 {
+		if wait := horse.LastMeetGreet + MeetAndGreetCooldown - gs.weekNumber(); wait > 0 {
+			return fmt.Errorf("next meet-and-greet in %d weeks", wait)
+		}
+	}
+	return nil
+}
+
+// MeetAndGreet spends a day meeting fans instead of training, lifting morale and winning new fans
+func (gs *GameState) MeetAndGreet(horse *Horse, day int) (int, error) {
+	if err := gs.CanMeetAndGreet(horse); err != nil {
+		return 0, err
+	}
+	for _, scheduled := range gs.Season.GetHorseTrainingDays(horse.ID) {
+		if scheduled.Day == day && scheduled.IsCompleted {
+			return 0, fmt.Errorf("day %d is already used", day+1)
+		}
+	}
+
+	fans := 200 + horse.FanSupport/20
+	horse.Morale = min(horse.Morale+15, 100)
+	horse.LastMeetGreet = gs.weekNumber()
+	gs.AddFans(horse, fans)
+
+	gs.Season.AddTrainingDay(TrainingDay{
+		HorseID:     horse.ID,
+		Week:        gs.Season.CurrentWeek,
+		Day:         day,
+		IsRest:      true,
+		IsCompleted: true,
+		Activity:    "Meet-and-greet",
+	})
+	return fans, nil
+}
@@ -0,0 +1,330 @@
+package models
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+)
+
+// ScoutCost is the price in scout tokens of a single banner draw; ScoutMultiCost buys ScoutMultiCount draws
+const (
+	ScoutCost       = 50
+	ScoutMultiCost  = 450
+	ScoutMultiCount = 10
+)
+
+// ScoutPityThreshold is how many draws without an Ultra Rare guarantee one on the next draw
+const ScoutPityThreshold = 40
+
+// ScoutFeaturedShare is the share of a rarity's rate that goes to that rarity's featured card
+const ScoutFeaturedShare = 0.5
+
+// scoutHistoryLimit is how many draws and purchases are kept in the history
+const scoutHistoryLimit = 100
+
+// manualsBundle is the training manuals bundle always on sale in the shop
+const (
+	manualsBundleSize  = 25
+	manualsBundlePrice = 100
+)
+
+// ScoutRate is the published chance of drawing a rarity from the banner
+type ScoutRate struct {
+	Rarity  Rarity
+	Percent float64
+}
+
+// ScoutRates are the banner's published rates, rarest first
+var ScoutRates = []ScoutRate{
+	{UltraRare, 3},
+	{SuperRare, 12},
+	{Rare, 30},
+	{Common, 55},
+}
+
+// ScoutRecord is one entry in the draw history
+type ScoutRecord struct {
+	Season      int    `json:"season"`
+	Week        int    `json:"week"`
+	Source      string `json:"source"` // "Banner" or "Shop"
+	SupporterID string `json:"supporter_id"`
+	Name        string `json:"name"`
+	Rarity      Rarity `json:"rarity"`
+	Featured    bool   `json:"featured,omitempty"`
+	Pity        bool   `json:"pity,omitempty"` // Ultra Rare guaranteed by the pity counter
+	Outcome     string `json:"outcome"`
+}
+
+// ShopItem is a supporter card for sale in this week's shop
+type ShopItem struct {
+	Supporter *Supporter
+	Price     int
+	Sold      bool
+}
+
+// shopPrices are the scout token prices of shop cards by rarity
+var shopPrices = map[Rarity]int{
+	Common:    120,
+	Rare:      250,
+	SuperRare: 600,
+	UltraRare: 1500,
+}
+
+// RaceScoutTokens returns the scout tokens a race finish earns from the grade, position and fans won
+func RaceScoutTokens(grade RaceGrade, position, fansGained int) int {
+	base := map[RaceGrade]int{
+		MaidenRace: 10,
+		Grade3:     20,
+		Grade2:     35,
+		Grade1:     60,
+		GradeG1:    100,
+	}[grade]
+
+	switch position {
+	case 1:
+	case 2:
+		base = base * 3 / 4
+	case 3:
+		base = base / 2
+	default:
+		base = base / 4
+	}
+	return base + fansGained/200
+}
+
+// AwardRaceScoutTokens adds the scout tokens for a race finish and returns the amount
+func (gs *GameState) AwardRaceScoutTokens(grade RaceGrade, position, fansGained int) int {
+	tokens := RaceScoutTokens(grade, position, fansGained)
+	gs.ScoutTokens += tokens
+	return tokens
+}
+
+// FeaturedSupporters returns the Ultra Rare and Super Rare cards featured on this season's banner.
+// The featured cards rotate each season.
+func (gs *GameState) FeaturedSupporters() []*Supporter {
+	var featured []*Supporter
+	for _, rarity := range []Rarity{UltraRare, SuperRare} {
+		cards := gs.supportersOfRarity(rarity)
+		if len(cards) > 0 {
+			featured = append(featured, cards[(max(gs.Season.Number, 1)-1)%len(cards)])
+		}
+	}
+	return featured
+}
+
+// featuredFor returns the featured card of a rarity, if there is one
+func (gs *GameState) featuredFor(rarity Rarity) *Supporter {
+	for _, supporter := range gs.FeaturedSupporters() {
+		if supporter.Rarity == rarity {
+			return supporter
+		}
+	}
+	return nil
+}
+
+// supportersOfRarity returns every card of a rarity in a stable order
+func (gs *GameState) supportersOfRarity(rarity Rarity) []*Supporter {
+	var cards []*Supporter
+	for i := range gs.Supporters {
+		if gs.Supporters[i].Rarity == rarity {
+			cards = append(cards, &gs.Supporters[i])
+		}
+	}
+	sort.Slice(cards, func(a, b int) bool { return cards[a].ID < cards[b].ID })
+	return cards
+}
+
+// DrawsUntilPity returns how many more draws guarantee an Ultra Rare
+func (gs *GameState) DrawsUntilPity() int {
+	return ScoutPityThreshold - gs.ScoutPity
+}
+
+// Scout draws count cards from the banner, paying in scout tokens. A multi-draw of
+// ScoutMultiCount cards costs less and always includes a Super Rare or better.
+func (gs *GameState) Scout(count int) ([]ScoutRecord, error) {
+	cost := ScoutCost * count
+	if count == ScoutMultiCount {
+		cost = ScoutMultiCost
+	}
+	if gs.ScoutTokens < cost {
+		return nil, fmt.Errorf("not enough scout tokens: need %d, have %d", cost, gs.ScoutTokens)
+	}
+	if len(gs.Supporters) == 0 {
+		return nil, fmt.Errorf("no supporters to scout")
+	}
+
+	gs.ScoutTokens -= cost
+	var records []ScoutRecord
+	rareOrBetter := false
+	for i := 0; i < count; i++ {
+		rarity, pity := gs.rollScoutRarity()
+		if count == ScoutMultiCount && i == count-1 && !rareOrBetter && rarity < SuperRare {
+			rarity = SuperRare
+		}
+		record := gs.drawCard(rarity, pity)
+		if record.Rarity >= SuperRare {
+			rareOrBetter = true
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// rollScoutRarity rolls a rarity from the published rates, applying the pity counter
+func (gs *GameState) rollScoutRarity() (Rarity, bool) {
+	if gs.ScoutPity+1 >= ScoutPityThreshold {
+		return UltraRare, true
+	}
+
+	roll := rand.Float64() * 100
+	for _, rate := range ScoutRates {
+		if roll < rate.Percent {
+			return rate.Rarity, false
+		}
+		roll -= rate.Percent
+	}
+	return Common, false
+}
+
+// drawCard picks a card of the rarity, favouring the featured card, and records the draw.
+// A rarity with no cards falls back to the nearest one that has some, and the draw is
+// recorded and counted toward pity as the rarity actually drawn.
+func (gs *GameState) drawCard(rarity Rarity, pity bool) ScoutRecord {
+	rarity = gs.nearestStockedRarity(rarity)
+	pity = pity && rarity == UltraRare
+	if rarity == UltraRare {
+		gs.ScoutPity = 0
+	} else {
+		gs.ScoutPity++
+	}
+
+	var supporter *Supporter
+	featured := gs.featuredFor(rarity)
+	if featured != nil && rand.Float64() < ScoutFeaturedShare {
+		supporter = featured
+	} else {
+		cards := gs.supportersOfRarity(rarity)
+		supporter = cards[rand.Intn(len(cards))]
+	}
+
+	record := gs.newScoutRecord("Banner", gs.grantSupporter(supporter))
+	record.Featured = featured != nil && featured.ID == supporter.ID
+	record.Pity = pity
+	gs.addScoutRecord(record)
+	return record
+}
+
+// nearestStockedRarity returns the rarity if any card has it, or else the closest rarity
+// that does, the commoner one on a tie
+func (gs *GameState) nearestStockedRarity(rarity Rarity) Rarity {
+	for distance := 0; distance <= int(UltraRare-Common); distance++ {
+		for _, candidate := range []Rarity{rarity - Rarity(distance), rarity + Rarity(distance)} {
+			if candidate >= Common && candidate <= UltraRare && len(gs.supportersOfRarity(candidate)) > 0 {
+				return candidate
+			}
+		}
+	}
+	return rarity
+}
+
+// newScoutRecord describes a draw or purchase for the history
+func (gs *GameState) newScoutRecord(source string, drop *CardDrop) ScoutRecord {
+	return ScoutRecord{
+		Season:      gs.Season.Number,
+		Week:        gs.Season.CurrentWeek,
+		Source:      source,
+		SupporterID: drop.Supporter.ID,
+		Name:        drop.Supporter.Name,
+		Rarity:      drop.Supporter.Rarity,
+		Outcome:     drop.Message(),
+	}
+}
+
+// addScoutRecord adds an entry to the draw history, dropping the oldest past the limit
+func (gs *GameState) addScoutRecord(record ScoutRecord) {
+	gs.ScoutHistory = append(gs.ScoutHistory, record)
+	if len(gs.ScoutHistory) > scoutHistoryLimit {
+		gs.ScoutHistory = gs.ScoutHistory[len(gs.ScoutHistory)-scoutHistoryLimit:]
+	}
+}
+
+// shopWeekKey identifies the current week's shop stock
+func (gs *GameState) shopWeekKey() string {
+	return fmt.Sprintf("%d-%d", gs.Season.Number, gs.Season.CurrentWeek)
+}
+
+// ShopStock returns this week's cards for sale. Stock changes every week and each card
+// can be bought once.
+func (gs *GameState) ShopStock() []ShopItem {
+	if len(gs.Supporters) == 0 {
+		return nil
+	}
+
+	rng := rand.New(rand.NewSource(int64(gs.Season.Number*100 + gs.Season.CurrentWeek)))
+	order := rng.Perm(len(gs.Supporters))
+
+	var stock []ShopItem
+	for _, i := range order[:min(4, len(order))] {
+		supporter := &gs.Supporters[i]
+		stock = append(stock, ShopItem{
+			Supporter: supporter,
+			Price:     shopPrices[supporter.Rarity],
+			Sold:      gs.shopSold(supporter.ID),
+		})
+	}
+	return stock
+}
+
+func (gs *GameState) shopSold(supporterID string) bool {
+	if gs.ShopSoldWeek != gs.shopWeekKey() {
+		return false
+	}
+	for _, id := range gs.ShopSold {
+		if id == supporterID {
+			return true
+		}
+	}
+	return false
+}
+
+// BuyShopCard buys a card from this week's shop stock with scout tokens
+func (gs *GameState) BuyShopCard(supporterID string) (*CardDrop, error) {
+	for _, item := range gs.ShopStock() {
+		if item.Supporter.ID != supporterID {
+			continue
+		}
+		if item.Sold {
+			return nil, fmt.Errorf("%s is sold out this week", item.Supporter.Name)
+		}
+		if gs.ScoutTokens < item.Price {
+			return nil, fmt.Errorf("not enough scout tokens: need %d, have %d", item.Price, gs.ScoutTokens)
+		}
+
+		gs.ScoutTokens -= item.Price
+		if gs.ShopSoldWeek != gs.shopWeekKey() {
+			gs.ShopSoldWeek = gs.shopWeekKey()
+			gs.ShopSold = nil
+		}
+		gs.ShopSold = append(gs.ShopSold, supporterID)
+
+		drop := gs.grantSupporter(item.Supporter)
+		gs.addScoutRecord(gs.newScoutRecord("Shop", drop))
+		return drop, nil
+	}
+	return nil, fmt.Errorf("supporter not in stock")
+}
+
+// ManualsBundle returns the size and scout token price of the shop's training manuals bundle
+func ManualsBundle() (int, int) {
+	return manualsBundleSize, manualsBundlePrice
+}
+
+// BuyManualsBundle trades scout tokens for training manuals
+func (gs *GameState) BuyManualsBundle() error {
+	if gs.ScoutTokens < manualsBundlePrice {
+		return fmt.Errorf("not enough scout tokens: need %d, have %d", manualsBundlePrice, gs.ScoutTokens)
+	}
+	gs.ScoutTokens -= manualsBundlePrice
+	gs.TrainingManuals += manualsBundleSize
+	return nil
+}
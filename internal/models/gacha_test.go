@@ -0,0 +1,54 @@
+package models
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestScoutPity(t *testing.T) {
+	everyRarity := []Rarity{Common, Rare, SuperRare, UltraRare}
+
+	tests := []struct {
+		name       string
+		stock      []Rarity // Rarities with a card on the banner
+		startPity  int
+		pityRoll   bool   // Roll the rarity, expecting the pity guarantee, instead of drawing rarity
+		rarity     Rarity // Rarity drawn when not rolled
+		wantRarity Rarity
+		wantPity   bool
+		wantCount  int
+	}{
+		{"the pity threshold guarantees an ultra rare", everyRarity, ScoutPityThreshold - 1, true, 0, UltraRare, true, 0},
+		{"an ultra rare resets the counter", everyRarity, 10, false, UltraRare, UltraRare, false, 0},
+		{"other rarities count toward pity", everyRarity, 10, false, Rare, Rare, false, 11},
+		{"a guaranteed ultra rare with none stocked falls back and keeps counting", []Rarity{Common, Rare, SuperRare}, ScoutPityThreshold - 1, true, 0, SuperRare, false, ScoutPityThreshold},
+		{"a missing common falls back to rare", []Rarity{Rare, UltraRare}, 0, false, Common, Rare, false, 1},
+		{"a tie falls back to the commoner rarity", []Rarity{Common, SuperRare}, 0, false, Rare, Common, false, 1},
+		{"a missing rarity can fall back to an ultra rare", []Rarity{UltraRare}, 10, false, SuperRare, UltraRare, false, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gs := &GameState{Season: Season{Number: 1}, ScoutPity: tt.startPity}
+			for i, rarity := range tt.stock {
+				gs.Supporters = append(gs.Supporters, Supporter{ID: fmt.Sprintf("sup_%d", i), Name: rarity.String(), Rarity: rarity})
+			}
+
+			rarity, pity := tt.rarity, false
+			if tt.pityRoll {
+				rarity, pity = gs.rollScoutRarity()
+				if rarity != UltraRare || !pity {
+					t.Fatalf("rollScoutRarity() = %s, %v, want a pity Ultra Rare", rarity.String(), pity)
+				}
+			}
+
+			record := gs.drawCard(rarity, pity)
+			if record.Rarity != tt.wantRarity || record.Pity != tt.wantPity {
+				t.Errorf("drew %s (pity %v), want %s (pity %v)", record.Rarity.String(), record.Pity, tt.wantRarity.String(), tt.wantPity)
+			}
+			if gs.ScoutPity != tt.wantCount {
+				t.Errorf("pity counter = %d, want %d", gs.ScoutPity, tt.wantCount)
+			}
+		})
+	}
+}
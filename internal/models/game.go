@@ -6,17 +6,45 @@ import (
 )
 
 type GameState struct {
-	PlayerHorse       *Horse           `json:"player_horse"`
-	Supporters        []Supporter      `json:"supporters"`
-	ActiveSupporters  []string         `json:"active_supporters"` // IDs of selected supporters (max 4)
-	AvailableHorses   []Horse          `json:"available_horses"`
-	AvailableRaces    []Race           `json:"available_races"`
-	Season            Season           `json:"season"`
-	GameStats         GameStats        `json:"game_stats"`
-	AllCompletedRaces []string         `json:"all_completed_races"` // Track all races ever completed across seasons
-	RetiredHorses     []RetiredHorse   `json:"retired_horses"`      // Gallery of retired horses
-	RetirementHomes   []RetirementHome `json:"retirement_homes"`    // Available retirement homes
-	SavedAt           time.Time        `json:"saved_at"`
+	PlayerHorse         *Horse              `json:"player_horse"` // Active horse, points into Stable
+	Treasury            int                 `json:"treasury"`     // Stable-wide spendable cash
+	Ledger              []LedgerEntry       `json:"ledger"`
+	Stable              []*Horse            `json:"stable"`
+	ActiveHorseID       string              `json:"active_horse_id"`
+	HorseSupporters     map[string][]string `json:"horse_supporters"` // Horse ID -> supporter deck
+	BarnLevel           int                 `json:"barn_level"`
+	Supporters          []Supporter         `json:"supporters"`
+	ActiveSupporters    []string            `json:"active_supporters"` // IDs of selected supporters (max 4)
+	AvailableHorses     []Horse             `json:"available_horses"`
+	AvailableRaces      []Race              `json:"available_races"`
+	Season              Season              `json:"season"`
+	GameStats           GameStats           `json:"game_stats"`
+	AllCompletedRaces   []string            `json:"all_completed_races"`     // Track all races ever completed across seasons
+	RetiredHorses       []RetiredHorse      `json:"retired_horses"`          // Gallery of retired horses
+	RetirementHomes     []RetirementHome    `json:"retirement_homes"`        // Available retirement homes
+	Foals               []Horse             `json:"foals"`                   // Foals bred in the stable, offered when scouting
+	Notices             []string            `json:"notices"`                 // Announcements shown on the main menu
+	PendingEvent        *Event              `json:"pending_event,omitempty"` // Event waiting for a decision, saved so quitting doesn't lose it
+	PendingEventHorseID string              `json:"pending_event_horse_id,omitempty"`
+	EventLibrary        *EventLibrary       `json:"-"`                          // Event definitions loaded from data files
+	SupporterProfiles   ProfileLibrary      `json:"-"`                          // Supporter flavour loaded from data files
+	Commentary          CommentaryLibrary   `json:"-"`                          // Race commentary packs loaded from data files
+	CommentaryPack      string              `json:"commentary_pack,omitempty"`  // ID of the commentary pack the player picked
+	TrainingPlans       []TrainingPlan      `json:"training_plans,omitempty"`   // Plan templates saved by the player
+	PlanSchedule        []ScheduledPlan     `json:"plan_schedule,omitempty"`    // Plans assigned to upcoming weeks
+	FacilityLevels      map[string]int      `json:"facility_levels,omitempty"`  // Facility ID -> built level
+	TrainingManuals     int                 `json:"training_manuals,omitempty"` // Materials for levelling supporter cards
+	ScoutTokens         int                 `json:"scout_tokens,omitempty"`     // Currency for the scouting banner and supporter shop
+	ScoutPity           int                 `json:"scout_pity,omitempty"`       // Banner draws since the last Ultra Rare
+	ScoutHistory        []ScoutRecord       `json:"scout_history,omitempty"`    // Recent banner draws and shop purchases
+	ShopSold            []string            `json:"shop_sold,omitempty"`        // Supporter IDs bought from this week's shop
+	ShopSoldWeek        string              `json:"shop_sold_week,omitempty"`   // Season-week the sold list belongs to
+	DeckPresets         []DeckPreset        `json:"deck_presets,omitempty"`     // Named supporter decks saved by the player
+	Contracts           []SponsorContract   `json:"contracts,omitempty"`        // Sponsor contracts signed, active and recently finished
+	JockeyBookings      []JockeyBooking     `json:"jockey_bookings,omitempty"`  // Jockeys booked to ride each horse
+	JockeyRapport       map[string]int      `json:"jockey_rapport,omitempty"`   // "jockeyID/horseID" -> rapport from riding together
+	G1WinsCounted       bool                `json:"g1_wins_counted,omitempty"`  // Set once GameStats.G1Wins covers every race run
+	SavedAt             time.Time           `json:"saved_at"`
 }
 
 type Season struct {
@@ -24,18 +52,22 @@ type Season struct {
 	CurrentWeek     int                            `json:"current_week"`
 	MaxWeeks        int                            `json:"max_weeks"`
 	TrainingDays    []TrainingDay                  `json:"training_days"`
-	CompletedRaces  []string                       `json:"completed_races"` // Race IDs
-	RaceResults     map[string]CompletedRaceResult `json:"race_results"`    // Race ID -> Result
+	CompletedRaces  []string                       `json:"completed_races"` // Race IDs, each listed once
+	RaceResults     map[string]CompletedRaceResult `json:"race_results"`    // "raceID/horseID" -> Result
+	PassiveIncome   int                            `json:"passive_income"`  // Retirement income earned this season
+	PassiveFame     int                            `json:"passive_fame"`    // Retirement fame earned this season
 	SeasonStartDate time.Time                      `json:"season_start_date"`
 }
 
 type TrainingDay struct {
+	HorseID      string          `json:"horse_id"`
 	Week         int             `json:"week"`
 	Day          int             `json:"day"`
 	TrainingType TrainingType    `json:"training_type"`
 	IsRest       bool            `json:"is_rest"`
 	IsCompleted  bool            `json:"is_completed"`
 	Result       *TrainingResult `json:"result,omitempty"`
+	Activity     string          `json:"activity,omitempty"` // What the day was spent on instead of training, if anything
 }
 
 func NewSeason(number int) Season {
@@ -50,6 +82,11 @@ func NewSeason(number int) Season {
 	}
 }
 
+// WeekOf returns the season week a date falls in, counting from the season's start
+func (s Season) WeekOf(date time.Time) int {
+	return int(date.Sub(s.SeasonStartDate).Hours()/24/7) + 1
+}
+
 func (s *Season) GetCurrentTrainingDays() []TrainingDay {
 	var currentDays []TrainingDay
 	for _, day := range s.TrainingDays {
@@ -60,6 +97,17 @@ func (s *Season) GetCurrentTrainingDays() []TrainingDay {
 	return currentDays
 }
 
+// GetHorseTrainingDays returns a horse's training days for the current week
+func (s *Season) GetHorseTrainingDays(horseID string) []TrainingDay {
+	var days []TrainingDay
+	for _, day := range s.TrainingDays {
+		if day.Week == s.CurrentWeek && day.HorseID == horseID {
+			days = append(days, day)
+		}
+	}
+	return days
+}
+
 func (s *Season) AddTrainingDay(day TrainingDay) {
 	s.TrainingDays = append(s.TrainingDays, day)
 }
@@ -80,6 +128,7 @@ type GameStats struct {
 	TotalPrizeMoney  int `json:"total_prize_money"`
 	TotalFans        int `json:"total_fans"`
 	SeasonsCompleted int `json:"seasons_completed"`
+	G1Wins           int `json:"g1_wins"`   // Wins in G1 and GI races
 	PlayTime         int `json:"play_time"` // in minutes
 }
 
@@ -91,6 +140,8 @@ type Event struct {
 	Choices     []EventChoice  `json:"choices"`
 	Effects     map[string]int `json:"effects"` // stat name -> change
 	Probability float64        `json:"probability"`
+	Skill       string         `json:"skill,omitempty"` // Skill ID taught by events without choices
+	Hint        string         `json:"hint,omitempty"`  // Skill ID hinted at by events without choices
 }
 
 type EventType int
@@ -103,23 +154,33 @@ const (
 
 type EventChoice struct {
 	Text    string         `json:"text"`
-	Effects map[string]int `json:"effects"` // stat name -> change
+	Effects map[string]int `json:"effects"`         // stat name -> change
+	Skill   string         `json:"skill,omitempty"` // Skill ID taught by this choice
+	Hint    string         `json:"hint,omitempty"`  // Skill ID this choice gives a hint toward
 }
 
 func NewGameState() *GameState {
-	return &GameState{
+	gs := &GameState{
 		PlayerHorse:       nil,
+		Stable:            make([]*Horse, 0),
+		HorseSupporters:   make(map[string][]string),
+		BarnLevel:         1,
 		Supporters:        make([]Supporter, 0),
 		ActiveSupporters:  make([]string, 0),
 		AvailableHorses:   make([]Horse, 0),
 		AvailableRaces:    make([]Race, 0),
 		Season:            NewSeason(1),
 		GameStats:         GameStats{},
+		G1WinsCounted:     true,
 		AllCompletedRaces: make([]string, 0),
 		RetiredHorses:     make([]RetiredHorse, 0),
 		RetirementHomes:   initializeRetirementHomes(),
+		Foals:             make([]Horse, 0),
+		Ledger:            make([]LedgerEntry, 0),
 		SavedAt:           time.Now(),
 	}
+	gs.Earn(OpeningBalance, "Opening balance", StartingTreasury, "")
+	return gs
 }
 
 // GetActiveSupporters returns the supporters that are currently selected/active
@@ -150,7 +211,7 @@ func (gs *GameState) GetOwnedSupporters() []Supporter {
 // CanSelectSupporter checks if a supporter can be selected (owned and not at 4 limit)
 func (gs *GameState) CanSelectSupporter(supporterID string) bool {
 	// Check if we already have 4 active supporters
-	if len(gs.ActiveSupporters) >= 4 {
+	if len(gs.ActiveSupporters) >= MaxDeckSize {
 		return false
 	}
 
@@ -216,22 +277,27 @@ type RetiredHorse struct {
 	PostRetirementRole PostRetirementRole `json:"post_retirement_role"`
 	CareerHighlights   CareerHighlights   `json:"career_highlights"`
 	Awards             []Award            `json:"awards"`
-	PassiveIncome      int                `json:"passive_income"`    // Monthly passive income
-	PassiveFame        int                `json:"passive_fame"`      // Monthly passive fame
-	LastPassiveGain    time.Time          `json:"last_passive_gain"` // Track when passive gains were last calculated
+	PassiveIncome      int                `json:"passive_income"`   // Passive income per in-game month (4 weeks)
+	PassiveFame        int                `json:"passive_fame"`     // Passive fame per in-game month (4 weeks)
+	LastBredSeason     int                `json:"last_bred_season"` // Season the horse was last bred (breeding mares)
+	FoalsProduced      int                `json:"foals_produced"`
 }
 
 type RetirementHome struct {
-	ID               string  `json:"id"`
-	Name             string  `json:"name"`
-	Description      string  `json:"description"`
-	Cost             int     `json:"cost"`
-	Tier             int     `json:"tier"`              // 1=Basic, 2=Premium, 3=Luxury
-	IsUnlocked       bool    `json:"is_unlocked"`       // Whether player can access this home
-	IsOwned          bool    `json:"is_owned"`          // Whether player has purchased this home
-	Capacity         int     `json:"capacity"`          // How many horses can retire here
-	IncomeMultiplier float64 `json:"income_multiplier"` // Multiplier for passive income generation
-	FameMultiplier   float64 `json:"fame_multiplier"`   // Multiplier for passive fame generation
+	ID               string          `json:"id"`
+	Name             string          `json:"name"`
+	Description      string          `json:"description"`
+	Cost             int             `json:"cost"`
+	Tier             int             `json:"tier"`              // 1=Basic, 2=Premium, 3=Luxury
+	IsUnlocked       bool            `json:"is_unlocked"`       // Whether player can access this home
+	IsOwned          bool            `json:"is_owned"`          // Whether player has purchased this home
+	Capacity         int             `json:"capacity"`          // How many horses can retire here
+	IncomeMultiplier float64         `json:"income_multiplier"` // Multiplier for passive income generation
+	FameMultiplier   float64         `json:"fame_multiplier"`   // Multiplier for passive fame generation
+	Requirement      HomeRequirement `json:"requirement"`       // What it takes to unlock this home
+	WeeklyUpkeep     int             `json:"weekly_upkeep"`     // Upkeep per level, charged every week while owned
+	Level            int             `json:"level"`
+	MaxLevel         int             `json:"max_level"`
 }
 
 type PostRetirementRole int
@@ -243,6 +309,14 @@ const (
 	TrainingMentor
 )
 
+// AvailableRoles returns the post-retirement roles open to a horse of the given sex
+func AvailableRoles(sex Sex) []PostRetirementRole {
+	if sex == Mare {
+		return []PostRetirementRole{ShowHorse, BreedingMare, TrainingMentor}
+	}
+	return []PostRetirementRole{ShowHorse, StudHorse, TrainingMentor}
+}
+
 func (r PostRetirementRole) String() string {
 	switch r {
 	case ShowHorse:
@@ -322,6 +396,9 @@ func initializeRetirementHomes() []RetirementHome {
 			Capacity:         2,
 			IncomeMultiplier: 0.5,
 			FameMultiplier:   0.3,
+			WeeklyUpkeep:     50,
+			Level:            1,
+			MaxLevel:         3,
 		},
 		{
 			ID:               "premium_ranch",
@@ -334,6 +411,9 @@ func initializeRetirementHomes() []RetirementHome {
 			Capacity:         4,
 			IncomeMultiplier: 1.0,
 			FameMultiplier:   0.8,
+			WeeklyUpkeep:     250,
+			Level:            1,
+			MaxLevel:         3,
 		},
 		{
 			ID:               "luxury_estate",
@@ -346,6 +426,10 @@ func initializeRetirementHomes() []RetirementHome {
 			Capacity:         6,
 			IncomeMultiplier: 2.0,
 			FameMultiplier:   1.5,
+			Requirement:      HomeRequirement{MinFans: 10000, MinG1Wins: 1},
+			WeeklyUpkeep:     750,
+			Level:            1,
+			MaxLevel:         3,
 		},
 		{
 			ID:               "champions_hall",
@@ -358,6 +442,10 @@ func initializeRetirementHomes() []RetirementHome {
 			Capacity:         8,
 			IncomeMultiplier: 3.0,
 			FameMultiplier:   2.5,
+			Requirement:      HomeRequirement{MinFans: 50000, MinG1Wins: 3, MinLegendaryAwards: 1},
+			WeeklyUpkeep:     2000,
+			Level:            1,
+			MaxLevel:         3,
 		},
 	}
 }
@@ -514,6 +602,49 @@ func (gs *GameState) RetireHorse(homeID string, role PostRetirementRole) error {
 	if gs.PlayerHorse == nil {
 		return fmt.Errorf("no horse to retire")
 	}
+	return gs.retireStableHorse(gs.PlayerHorse, homeID, role)
+}
+
+// RetireAgedHorses retires every stable horse that has reached RetirementAge to the first
+// owned home with room, as a show horse, and returns a notice for each. A horse with no
+// home to go to stops racing and waits in the stable to be retired by hand.
+func (gs *GameState) RetireAgedHorses() []string {
+	var notices []string
+	aged := make([]*Horse, 0)
+	for _, horse := range gs.Stable {
+		if horse.Age >= RetirementAge {
+			aged = append(aged, horse)
+		}
+	}
+
+	for _, horse := range aged {
+		home := gs.homeWithRoom()
+		if home == nil {
+			horse.IsRetired = true
+			notices = append(notices, fmt.Sprintf("🏁 %s has aged out of racing; make room in a retirement home to retire it", horse.Name))
+			continue
+		}
+		if err := gs.retireStableHorse(horse, home.ID, ShowHorse); err != nil {
+			notices = append(notices, fmt.Sprintf("⚠️ %s could not be retired: %v", horse.Name, err))
+			continue
+		}
+		notices = append(notices, fmt.Sprintf("🎖️ %s retires to %s after %d races", horse.Name, home.Name, horse.Races))
+	}
+	return notices
+}
+
+// homeWithRoom returns the first owned retirement home with a free place
+func (gs *GameState) homeWithRoom() *RetirementHome {
+	for i, home := range gs.RetirementHomes {
+		if home.IsOwned && gs.CountResidents(home.ID) < home.Capacity {
+			return &gs.RetirementHomes[i]
+		}
+	}
+	return nil
+}
+
+// retireStableHorse moves a stable horse into a retirement home with its career record
+func (gs *GameState) retireStableHorse(horse *Horse, homeID string, role PostRetirementRole) error {
 
 	// Find the retirement home
 	var selectedHome *RetirementHome
@@ -533,31 +664,27 @@ func (gs *GameState) RetireHorse(homeID string, role PostRetirementRole) error {
 	}
 
 	// Check capacity
-	currentResidents := 0
-	for _, retired := range gs.RetiredHorses {
-		if retired.RetirementHome.ID == homeID {
-			currentResidents++
-		}
+	if gs.CountResidents(homeID) >= selectedHome.Capacity {
+		return fmt.Errorf("retirement home at capacity")
 	}
 
-	if currentResidents >= selectedHome.Capacity {
-		return fmt.Errorf("retirement home at capacity")
+	if role == StudHorse && horse.Sex != Stallion {
+		return fmt.Errorf("only stallions can stand at stud")
+	}
+	if role == BreedingMare && horse.Sex != Mare {
+		return fmt.Errorf("only mares can join the breeding program")
 	}
 
 	// Calculate career highlights and awards
-	highlights := gs.CalculateCareerHighlights(gs.PlayerHorse)
-	awards := gs.CalculateAwards(gs.PlayerHorse, highlights)
+	highlights := gs.CalculateCareerHighlights(horse)
+	awards := gs.CalculateAwards(horse, highlights)
 
 	// Calculate passive income and fame based on performance and home quality
-	baseIncome := highlights.TotalPrizeMoney / 100 // 1% of career earnings per month
-	baseFame := highlights.TotalFanSupport / 50    // 2% of career fan support per month
-
-	passiveIncome := int(float64(baseIncome) * selectedHome.IncomeMultiplier)
-	passiveFame := int(float64(baseFame) * selectedHome.FameMultiplier)
+	passiveIncome, passiveFame := calculatePassiveGains(highlights, *selectedHome)
 
 	// Create retired horse record
 	retiredHorse := RetiredHorse{
-		Horse:              *gs.PlayerHorse,
+		Horse:              *horse,
 		RetiredAt:          time.Now(),
 		RetirementHome:     *selectedHome,
 		PostRetirementRole: role,
@@ -565,17 +692,16 @@ func (gs *GameState) RetireHorse(homeID string, role PostRetirementRole) error {
 		Awards:             awards,
 		PassiveIncome:      passiveIncome,
 		PassiveFame:        passiveFame,
-		LastPassiveGain:    time.Now(),
 	}
 
 	// Mark horse as retired
-	gs.PlayerHorse.IsRetired = true
+	horse.IsRetired = true
 
 	// Add to retired horses gallery
 	gs.RetiredHorses = append(gs.RetiredHorses, retiredHorse)
 
-	// Clear player horse
-	gs.PlayerHorse = nil
+	// Free the stall and move on to the next horse in the stable
+	gs.RemoveFromStable(horse.ID)
 
 	return nil
 }
@@ -584,7 +710,7 @@ func (gs *GameState) RetireHorse(homeID string, role PostRetirementRole) error {
 func (gs *GameState) GetAvailableRetirementHomes() []RetirementHome {
 	var available []RetirementHome
 	for _, home := range gs.RetirementHomes {
-		if home.IsUnlocked && (home.IsOwned || gs.PlayerHorse.Money >= home.Cost) {
+		if home.IsUnlocked && (home.IsOwned || gs.CanAfford(home.Cost)) {
 			available = append(available, home)
 		}
 	}
@@ -593,10 +719,6 @@ func (gs *GameState) GetAvailableRetirementHomes() []RetirementHome {
 
 // Purchase a retirement home
 func (gs *GameState) PurchaseRetirementHome(homeID string) error {
-	if gs.PlayerHorse == nil {
-		return fmt.Errorf("no horse to purchase retirement home")
-	}
-
 	for i, home := range gs.RetirementHomes {
 		if home.ID == homeID {
 			if !home.IsUnlocked {
@@ -605,11 +727,10 @@ func (gs *GameState) PurchaseRetirementHome(homeID string) error {
 			if home.IsOwned {
 				return fmt.Errorf("retirement home already owned")
 			}
-			if gs.PlayerHorse.Money < home.Cost {
-				return fmt.Errorf("insufficient funds")
+			if err := gs.Spend(PurchaseExpense, "Retirement home: "+home.Name, home.Cost, ""); err != nil {
+				return err
 			}
 
-			gs.PlayerHorse.Money -= home.Cost
 			gs.RetirementHomes[i].IsOwned = true
 			return nil
 		}
@@ -618,21 +739,57 @@ func (gs *GameState) PurchaseRetirementHome(homeID string) error {
 	return fmt.Errorf("retirement home not found")
 }
 
-// Update passive income and fame from retired horses
-func (gs *GameState) UpdatePassiveGains() {
-	currentTime := time.Now()
+// WeeksPerMonth is the number of in-game weeks that make up a passive income month
+const WeeksPerMonth = 4
 
-	for i, retired := range gs.RetiredHorses {
-		// Calculate months since last passive gain
-		monthsSince := int(currentTime.Sub(retired.LastPassiveGain).Hours() / (24 * 30))
+// AccruePassiveGains pays out one in-game week of passive income and fame from retired horses
+func (gs *GameState) AccruePassiveGains() {
+	week := gs.Season.CurrentWeek
 
-		if monthsSince >= 1 {
-			// Add passive income and fame
-			gs.GameStats.TotalPrizeMoney += retired.PassiveIncome * monthsSince
-			gs.GameStats.TotalFans += retired.PassiveFame * monthsSince
+	for _, retired := range gs.RetiredHorses {
+		// Spread the monthly amount across the weeks without losing the remainder
+		income := retired.PassiveIncome*week/WeeksPerMonth - retired.PassiveIncome*(week-1)/WeeksPerMonth
+		fame := retired.PassiveFame*week/WeeksPerMonth - retired.PassiveFame*(week-1)/WeeksPerMonth
 
-			// Update last passive gain time
-			gs.RetiredHorses[i].LastPassiveGain = currentTime
-		}
+		gs.Earn(PassiveIncome, "Passive income: "+retired.Horse.Name, income, retired.Horse.ID)
+		gs.GameStats.TotalPrizeMoney += income
+		gs.GameStats.TotalFans += fame
+		gs.Season.PassiveIncome += income
+		gs.Season.PassiveFame += fame
+	}
+}
+
+// GetMonthlyPassiveGains returns the combined monthly passive income and fame of all retired horses
+func (gs *GameState) GetMonthlyPassiveGains() (int, int) {
+	income, fame := 0, 0
+	for _, retired := range gs.RetiredHorses {
+		income += retired.PassiveIncome
+		fame += retired.PassiveFame
 	}
+	return income, fame
+}
+
+// AddNotice queues an announcement for the main menu
+func (gs *GameState) AddNotice(notice string) {
+	gs.Notices = append(gs.Notices, notice)
+}
+
+// TakeNotices returns and clears all pending announcements
+func (gs *GameState) TakeNotices() []string {
+	notices := gs.Notices
+	gs.Notices = nil
+	return notices
+}
+
+// DopePlayerHorse pays for and applies a doping treatment to the active horse
+func (gs *GameState) DopePlayerHorse() error {
+	if gs.PlayerHorse == nil {
+		return fmt.Errorf("no horse selected")
+	}
+	if err := gs.Spend(DopingExpense, "Doping: "+gs.PlayerHorse.Name, DopeCost, gs.PlayerHorse.ID); err != nil {
+		return err
+	}
+
+	gs.PlayerHorse.Dope()
+	return nil
 }
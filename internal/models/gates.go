@@ -0,0 +1,247 @@
+package models
+
+import (
+	"math"
+	"math/rand"
+)
+
+// Course is the layout of a racecourse, which decides how much the gate draw matters
+type Course int
+
+const (
+	Oval      Course = iota // A standard oval: inside draws have a slight edge into the first bend
+	TightOval               // Sharp bends and a short run to the first: the draw matters most
+	Galloping               // Sweeping bends and a long run to the first: the draw hardly matters
+	Straight                // No bends: horses drawn in the middle get the cleanest run
+)
+
+func (c Course) String() string {
+	switch c {
+	case Oval:
+		return "Oval"
+	case TightOval:
+		return "Tight Turns"
+	case Galloping:
+		return "Galloping"
+	case Straight:
+		return "Straight"
+	default:
+		return "Unknown"
+	}
+}
+
+// DrawNote explains which stalls the course favours
+func (c Course) DrawNote() string {
+	switch c {
+	case TightOval:
+		return "Inside draws are a big help, wide draws a real hindrance"
+	case Galloping:
+		return "Long run to the first bend, so the draw hardly matters"
+	case Straight:
+		return "No bends, so horses drawn in the middle get the cleanest run"
+	default:
+		return "Inside draws have a slight edge into the first bend"
+	}
+}
+
+// drawBias is the most speed a good draw gains, or a bad one loses, before the field settles
+func (c Course) drawBias() float64 {
+	switch c {
+	case TightOval:
+		return 0.08
+	case Galloping:
+		return 0.015
+	case Straight:
+		return 0.03
+	default:
+		return 0.04
+	}
+}
+
+// DrawSettles is the share of the race run before the draw stops mattering
+const DrawSettles = 0.25
+
+// GateDraw maps horse IDs to the starting stall each is drawn in, from 1 on the inside
+type GateDraw map[string]int
+
+// DrawGates draws a starting stall for every entrant
+func (r *Race) DrawGates() {
+	r.Draw = make(GateDraw, len(r.Entrants))
+	for i, stall := range rand.Perm(len(r.Entrants)) {
+		r.Draw[r.Entrants[i]] = stall + 1
+	}
+}
+
+// Stall returns the stall the horse was drawn in, or 0 if the gates haven't been drawn
+func (r *Race) Stall(horseID string) int {
+	return r.Draw[horseID]
+}
+
+// DrawLabel describes a stall as an inside, middle or outside draw
+func (r *Race) DrawLabel(horseID string) string {
+	stall, field := r.Stall(horseID), len(r.Draw)
+	switch {
+	case stall == 0:
+		return "Undrawn"
+	case stall*3 <= field:
+		return "Inside"
+	case stall*3 > field*2:
+		return "Outside"
+	default:
+		return "Middle"
+	}
+}
+
+// DrawFactor returns the speed multiplier from the horse's draw until the field settles
+func (r *Race) DrawFactor(horseID string, progress float64) float64 {
+	stall, field := r.Stall(horseID), len(r.Draw)
+	if stall == 0 || field < 2 || progress > DrawSettles {
+		return 1.0
+	}
+
+	// 1 for the best stall on this course down to -1 for the worst
+	offset := 1.0 - 2.0*float64(stall-1)/float64(field-1)
+	if r.Course == Straight {
+		middle := float64(field+1) / 2
+		offset = 1.0 - 2.0*math.Abs(float64(stall)-middle)/(middle-1)
+	}
+	return 1.0 + r.Course.drawBias()*offset
+}
+
+// Temperament is how a horse copes with the starting gate
+type Temperament int
+
+const (
+	Steady Temperament = iota
+	Calm
+	Nervy
+	Fiery
+)
+
+func (t Temperament) String() string {
+	switch t {
+	case Steady:
+		return "Steady"
+	case Calm:
+		return "Calm"
+	case Nervy:
+		return "Nervy"
+	case Fiery:
+		return "Fiery"
+	default:
+		return "Unknown"
+	}
+}
+
+// gateRisk scales the chance of trouble at the start
+func (t Temperament) gateRisk() float64 {
+	switch t {
+	case Calm:
+		return 0.5
+	case Nervy:
+		return 1.6
+	case Fiery:
+		return 1.3
+	default:
+		return 1.0
+	}
+}
+
+// RandomTemperament draws a temperament for a new horse
+func RandomTemperament() Temperament {
+	roll := rand.Float64()
+	switch {
+	case roll < 0.25:
+		return Calm
+	case roll < 0.65:
+		return Steady
+	case roll < 0.85:
+		return Nervy
+	default:
+		return Fiery
+	}
+}
+
+// Break is how a horse leaves the starting gate
+type Break int
+
+const (
+	CleanBreak Break = iota
+	SlowStart
+	Reared
+	GateRefusal
+)
+
+func (b Break) String() string {
+	switch b {
+	case CleanBreak:
+		return "Clean Break"
+	case SlowStart:
+		return "Slow Start"
+	case Reared:
+		return "Reared"
+	case GateRefusal:
+		return "Refused"
+	default:
+		return "Unknown"
+	}
+}
+
+func (b Break) Icon() string {
+	switch b {
+	case SlowStart:
+		return "🐢"
+	case Reared:
+		return "⚠️"
+	case GateRefusal:
+		return "🚫"
+	default:
+		return ""
+	}
+}
+
+// delay is how many turns of running the break costs
+func (b Break) delay() float64 {
+	switch b {
+	case SlowStart:
+		return 0.5
+	case Reared:
+		return 1.0
+	case GateRefusal:
+		return 2.0
+	default:
+		return 0
+	}
+}
+
+// StartFactor returns the share of a turn's running the horse makes while it recovers from its break
+func (b Break) StartFactor(turn int) float64 {
+	return math.Min(math.Max(float64(turn)-b.delay(), 0), 1)
+}
+
+// Recovering reports whether the horse is still making up for a bad break on the given turn
+func (b Break) Recovering(turn int) bool {
+	return b != CleanBreak && float64(turn) < b.delay()+1
+}
+
+// BreakFromGate rolls how the horse leaves the gate: a strong mind and an easy temperament
+// keep it out of trouble, while anxious or hot-headed horses miss the break, rear or refuse
+func (h *Horse) BreakFromGate() Break {
+	chance := math.Min(18.0/float64(60+max(h.Mental, 0))*h.Temperament.gateRisk(), 0.5)
+	if rand.Float64() >= chance {
+		return CleanBreak
+	}
+
+	severity := rand.Float64()
+	if h.Temperament == Fiery {
+		severity += 0.2 // Fiery horses are quick to rear up
+	}
+	switch {
+	case severity < 0.6:
+		return SlowStart
+	case severity < 0.9 || h.Temperament == Fiery:
+		return Reared
+	default:
+		return GateRefusal
+	}
+}
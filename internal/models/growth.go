@@ -0,0 +1,180 @@
+package models
+
+import "math"
+
+// baseTrainingGain is the stat gain of a session before any modifiers
+const baseTrainingGain = 10
+
+// trainingMemory is how many recent sessions a horse remembers for the repetition penalty
+const trainingMemory = 6
+
+// GrowthProfile holds per-stat training gain multipliers for a breed
+type GrowthProfile struct {
+	Stamina   float64 `json:"stamina"`
+	Speed     float64 `json:"speed"`
+	Technique float64 `json:"technique"`
+	Mental    float64 `json:"mental"`
+}
+
+// ForType returns the multiplier for a training type
+func (p GrowthProfile) ForType(trainingType TrainingType) float64 {
+	switch trainingType.Stat() {
+	case StaminaTraining:
+		return p.Stamina
+	case SpeedTraining:
+		return p.Speed
+	case TechniqueTraining:
+		return p.Technique
+	case MentalTraining:
+		return p.Mental
+	default:
+		return 1.0
+	}
+}
+
+var breedGrowthProfiles = map[string]GrowthProfile{
+	"Thoroughbred":  {Stamina: 1.00, Speed: 1.15, Technique: 0.95, Mental: 0.90},
+	"Arabian":       {Stamina: 1.20, Speed: 1.00, Technique: 0.95, Mental: 0.90},
+	"Quarter Horse": {Stamina: 0.85, Speed: 1.20, Technique: 1.00, Mental: 0.95},
+	"Mustang":       {Stamina: 1.10, Speed: 0.90, Technique: 0.95, Mental: 1.15},
+	"Friesian":      {Stamina: 1.00, Speed: 0.85, Technique: 1.15, Mental: 1.05},
+	"Clydesdale":    {Stamina: 1.20, Speed: 0.80, Technique: 0.95, Mental: 1.10},
+	"Appaloosa":     {Stamina: 0.95, Speed: 0.95, Technique: 1.10, Mental: 1.05},
+	"Paint Horse":   {Stamina: 1.00, Speed: 1.00, Technique: 1.00, Mental: 1.00},
+}
+
+// GetGrowthProfile returns the growth profile for a breed, or a neutral profile for unknown breeds
+func GetGrowthProfile(breed string) GrowthProfile {
+	if profile, ok := breedGrowthProfiles[breed]; ok {
+		return profile
+	}
+	return GrowthProfile{Stamina: 1.0, Speed: 1.0, Technique: 1.0, Mental: 1.0}
+}
+
+// TrainingPreview breaks down the expected stat gain of a training session
+type TrainingPreview struct {
+	BaseGain       int     // Base gain plus supporter and facility bonuses
+	SupporterBonus int     // Portion of the base gain from supporters, including deck synergies
+	SynergyBonus   int     // Portion of the supporter bonus from deck synergies
+	FacilityBonus  int     // Portion of the base gain from facilities
+	Fatigue        int     // Fatigue the session adds
+	Morale         float64 // Morale multiplier
+	Breed          float64 // Breed growth profile multiplier
+	Age            float64 // Age growth curve multiplier
+	Repetition     float64 // Penalty for repeating the same training
+	CapFalloff     float64 // Penalty for training close to the stat cap
+	Friendship     float64 // Friendship training burst from best-friend supporters
+	Gain           int     // Final stat gain, limited by the stat cap
+}
+
+// PreviewTraining calculates the stat gain a training session would give without applying it
+func (h *Horse) PreviewTraining(trainingType TrainingType, supporters []Supporter, facilities FacilityBonus) TrainingPreview {
+	bonus := CalculateSupporterBonus(supporters, trainingType)
+	preview := TrainingPreview{
+		BaseGain:       baseTrainingGain + bonus + facilities.Gain,
+		SupporterBonus: bonus,
+		SynergyBonus:   SynergyBonus(supporters, trainingType),
+		FacilityBonus:  facilities.Gain,
+		Fatigue:        facilities.SessionFatigue(trainingType),
+		Morale:         moraleMultiplier(h.Morale),
+		Breed:          GetGrowthProfile(h.Breed).ForType(trainingType),
+		Age:            ageGrowthMultiplier(h.Age),
+		Repetition:     h.repetitionMultiplier(trainingType),
+		Friendship:     friendshipMultiplier(supporters, trainingType),
+	}
+
+	current, maximum := h.statValues(trainingType)
+	preview.CapFalloff = capFalloffMultiplier(current, maximum)
+	if current >= maximum {
+		return preview
+	}
+
+	gain := float64(preview.BaseGain) * preview.Morale * preview.Breed * preview.Age *
+		preview.Repetition * preview.CapFalloff * preview.Friendship
+	preview.Gain = min(max(int(math.Round(gain)), 1), maximum-current)
+	return preview
+}
+
+// moraleMultiplier scales training gains by the horse's morale
+func moraleMultiplier(morale int) float64 {
+	switch {
+	case morale >= 100:
+		return 1.20 // 20% bonus for excellent morale
+	case morale >= 80:
+		return 1.10 // 10% bonus for good morale
+	case morale >= 60:
+		return 1.00 // Normal training
+	case morale >= 40:
+		return 0.90 // 10% penalty for low morale
+	default:
+		return 0.80 // 20% penalty for very low morale
+	}
+}
+
+// ageGrowthMultiplier favours young horses, which develop faster than older ones
+func ageGrowthMultiplier(age int) float64 {
+	switch {
+	case age <= 2:
+		return 1.25
+	case age == 3:
+		return 1.15
+	case age == 4:
+		return 1.00
+	case age == 5:
+		return 0.90
+	case age == 6:
+		return 0.80
+	case age == 7:
+		return 0.70
+	default:
+		return 0.60
+	}
+}
+
+// capFalloffMultiplier shrinks gains once a stat passes 60% of its cap, down to 25% at the cap
+func capFalloffMultiplier(current, maximum int) float64 {
+	if maximum <= 0 {
+		return 1.0
+	}
+	ratio := float64(current) / float64(maximum)
+	if ratio <= 0.6 {
+		return 1.0
+	}
+	return math.Max(1.0-(ratio-0.6)*1.875, 0.25)
+}
+
+// repetitionMultiplier reduces gains for each consecutive recent session of the same type
+func (h *Horse) repetitionMultiplier(trainingType TrainingType) float64 {
+	streak := 0
+	for i := len(h.RecentTraining) - 1; i >= 0; i-- {
+		if h.RecentTraining[i] != trainingType {
+			break
+		}
+		streak++
+	}
+	return math.Max(1.0-0.15*float64(streak), 0.4)
+}
+
+// recordTraining remembers a session for the repetition penalty
+func (h *Horse) recordTraining(trainingType TrainingType) {
+	h.RecentTraining = append(h.RecentTraining, trainingType)
+	if len(h.RecentTraining) > trainingMemory {
+		h.RecentTraining = h.RecentTraining[len(h.RecentTraining)-trainingMemory:]
+	}
+}
+
+// statValues returns the current and maximum value of the stat a training type improves
+func (h *Horse) statValues(trainingType TrainingType) (int, int) {
+	switch trainingType.Stat() {
+	case StaminaTraining:
+		return h.Stamina, h.MaxStamina
+	case SpeedTraining:
+		return h.Speed, h.MaxSpeed
+	case TechniqueTraining:
+		return h.Technique, h.MaxTechnique
+	case MentalTraining:
+		return h.Mental, h.MaxMental
+	default:
+		return 0, 0
+	}
+}
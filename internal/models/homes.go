@@ -0,0 +1,221 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// HomeRequirement describes the stable achievements needed to unlock a retirement home
+type HomeRequirement struct {
+	MinFans            int `json:"min_fans"`
+	MinG1Wins          int `json:"min_g1_wins"`
+	MinLegendaryAwards int `json:"min_legendary_awards"`
+}
+
+// String describes the requirement for display
+func (r HomeRequirement) String() string {
+	var parts []string
+	if r.MinFans > 0 {
+		parts = append(parts, fmt.Sprintf("%d fans", r.MinFans))
+	}
+	if r.MinG1Wins > 0 {
+		parts = append(parts, fmt.Sprintf("%d G1 wins", r.MinG1Wins))
+	}
+	if r.MinLegendaryAwards > 0 {
+		parts = append(parts, fmt.Sprintf("%d Legendary awards", r.MinLegendaryAwards))
+	}
+	if len(parts) == 0 {
+		return "None"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// homeCapacityPerLevel is the number of extra stalls each home upgrade adds
+const homeCapacityPerLevel = 2
+
+// UpgradeCost returns the price of the home's next upgrade
+func (h RetirementHome) UpgradeCost() int {
+	return max(h.Cost, 20000) / 2 * max(h.Level, 1)
+}
+
+// CurrentUpkeep returns the weekly upkeep at the home's current level
+func (h RetirementHome) CurrentUpkeep() int {
+	return h.WeeklyUpkeep * max(h.Level, 1)
+}
+
+// CountLegendaryAwards counts Legendary awards earned by all retired horses
+func (gs *GameState) CountLegendaryAwards() int {
+	count := 0
+	for _, retired := range gs.RetiredHorses {
+		for _, award := range retired.Awards {
+			if award.Rarity == AwardLegendary {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// MeetsHomeRequirement checks if the stable's achievements satisfy a home's unlock requirement
+func (gs *GameState) MeetsHomeRequirement(req HomeRequirement) bool {
+	return gs.GameStats.TotalFans >= req.MinFans &&
+		gs.GameStats.G1Wins >= req.MinG1Wins &&
+		gs.CountLegendaryAwards() >= req.MinLegendaryAwards
+}
+
+// CheckRetirementHomeUnlocks unlocks homes whose requirements are now met and
+// posts a notice for each one
+func (gs *GameState) CheckRetirementHomeUnlocks() []string {
+	var unlocked []string
+	for i, home := range gs.RetirementHomes {
+		if home.IsUnlocked || !gs.MeetsHomeRequirement(home.Requirement) {
+			continue
+		}
+		gs.RetirementHomes[i].IsUnlocked = true
+		unlocked = append(unlocked, home.Name)
+		gs.AddNotice(fmt.Sprintf("🔓 %s is now available as a retirement home!", home.Name))
+	}
+	return unlocked
+}
+
+// CountResidents returns how many retired horses live at a home
+func (gs *GameState) CountResidents(homeID string) int {
+	count := 0
+	for _, retired := range gs.RetiredHorses {
+		if retired.RetirementHome.ID == homeID {
+			count++
+		}
+	}
+	return count
+}
+
+// GetRetirementHome returns the home with the given ID
+func (gs *GameState) GetRetirementHome(homeID string) *RetirementHome {
+	for i := range gs.RetirementHomes {
+		if gs.RetirementHomes[i].ID == homeID {
+			return &gs.RetirementHomes[i]
+		}
+	}
+	return nil
+}
+
+// UpgradeRetirementHome raises a home's level, adding capacity
+func (gs *GameState) UpgradeRetirementHome(homeID string) error {
+	home := gs.GetRetirementHome(homeID)
+	if home == nil {
+		return fmt.Errorf("retirement home not found")
+	}
+	if !home.IsOwned {
+		return fmt.Errorf("retirement home not owned")
+	}
+	if home.Level >= home.MaxLevel {
+		return fmt.Errorf("retirement home already fully upgraded")
+	}
+
+	if err := gs.Spend(PurchaseExpense, fmt.Sprintf("%s upgrade to level %d", home.Name, home.Level+1), home.UpgradeCost(), ""); err != nil {
+		return err
+	}
+
+	home.Level++
+	home.Capacity += homeCapacityPerLevel
+	return nil
+}
+
+// MoveRetiredHorse relocates a retired horse to another owned home, recalculating its passive gains
+func (gs *GameState) MoveRetiredHorse(horseID, homeID string) error {
+	home := gs.GetRetirementHome(homeID)
+	if home == nil {
+		return fmt.Errorf("retirement home not found")
+	}
+	if !home.IsOwned {
+		return fmt.Errorf("retirement home not owned")
+	}
+
+	for i := range gs.RetiredHorses {
+		retired := &gs.RetiredHorses[i]
+		if retired.Horse.ID != horseID {
+			continue
+		}
+		if retired.RetirementHome.ID == homeID {
+			return fmt.Errorf("%s already lives at %s", retired.Horse.Name, home.Name)
+		}
+		if gs.CountResidents(homeID) >= home.Capacity {
+			return fmt.Errorf("retirement home at capacity")
+		}
+
+		retired.RetirementHome = *home
+		retired.PassiveIncome, retired.PassiveFame = calculatePassiveGains(retired.CareerHighlights, *home)
+		return nil
+	}
+
+	return fmt.Errorf("retired horse not found")
+}
+
+// ChargeHomeUpkeep deducts one week of upkeep for every owned retirement home
+func (gs *GameState) ChargeHomeUpkeep() {
+	for _, home := range gs.RetirementHomes {
+		if home.IsOwned && home.CurrentUpkeep() > 0 {
+			gs.Charge(UpkeepExpense, "Upkeep: "+home.Name, home.CurrentUpkeep(), "")
+		}
+	}
+}
+
+// GetWeeklyHomeUpkeep returns the combined weekly upkeep of all owned retirement homes
+func (gs *GameState) GetWeeklyHomeUpkeep() int {
+	total := 0
+	for _, home := range gs.RetirementHomes {
+		if home.IsOwned {
+			total += home.CurrentUpkeep()
+		}
+	}
+	return total
+}
+
+// SyncRetirementHomes fills in unlock requirements, upkeep and levels for homes
+// loaded from saves made before they existed
+func (gs *GameState) SyncRetirementHomes() {
+	defaults := initializeRetirementHomes()
+	for _, def := range defaults {
+		home := gs.GetRetirementHome(def.ID)
+		if home == nil {
+			gs.RetirementHomes = append(gs.RetirementHomes, def)
+			continue
+		}
+		home.Requirement = def.Requirement
+		home.WeeklyUpkeep = def.WeeklyUpkeep
+		home.MaxLevel = def.MaxLevel
+		if home.Level < 1 {
+			home.Level = 1
+		}
+	}
+}
+
+// BackfillG1Wins counts the G1 wins of saves made before GameStats.G1Wins was kept.
+// Only wins with a stored result count; earlier seasons' races kept no finishing
+// position, so they can't be credited.
+func (gs *GameState) BackfillG1Wins() {
+	if gs.G1WinsCounted {
+		return
+	}
+	gs.G1WinsCounted = true
+
+	wins := 0
+	for _, result := range gs.Season.RaceResults {
+		if result.Grade >= Grade1 && result.Position == 1 {
+			wins++
+		}
+	}
+	gs.GameStats.G1Wins = max(gs.GameStats.G1Wins, wins)
+	gs.CheckRetirementHomeUnlocks()
+}
+
+// calculatePassiveGains works out monthly passive income and fame from career performance and home quality
+func calculatePassiveGains(highlights CareerHighlights, home RetirementHome) (int, int) {
+	baseIncome := highlights.TotalPrizeMoney / 100 // 1% of career earnings per month
+	baseFame := highlights.TotalFanSupport / 50    // 2% of career fan support per month
+
+	// Famous horses keep drawing visitors long after they retire
+	income := float64(baseIncome) * home.IncomeMultiplier * FanTiers[FanTierFor(highlights.TotalFanSupport)].RetirementBonus
+
+	return int(income), int(float64(baseFame) * home.FameMultiplier)
+}
@@ -3,32 +3,44 @@ package models
 import (
 	cryptorand "crypto/rand"
 	"encoding/hex"
-	"math"
-	"math/rand"
 	"time"
 )
 
 type Horse struct {
-	ID           string    `json:"id"`
-	Name         string    `json:"name"`
-	Breed        string    `json:"breed"`
-	Age          int       `json:"age"`
-	Stamina      int       `json:"stamina"`
-	Speed        int       `json:"speed"`
-	Technique    int       `json:"technique"`
-	Mental       int       `json:"mental"`
-	MaxStamina   int       `json:"max_stamina"`
-	MaxSpeed     int       `json:"max_speed"`
-	MaxTechnique int       `json:"max_technique"`
-	MaxMental    int       `json:"max_mental"`
-	Fatigue      int       `json:"fatigue"`
-	Morale       int       `json:"morale"`
-	FanSupport   int       `json:"fan_support"`
-	Money        int       `json:"money"`
-	Wins         int       `json:"wins"`
-	Races        int       `json:"races"`
-	IsRetired    bool      `json:"is_retired"`
-	CreatedAt    time.Time `json:"created_at"`
+	ID             string         `json:"id"`
+	Name           string         `json:"name"`
+	Breed          string         `json:"breed"`
+	Age            int            `json:"age"`
+	Stamina        int            `json:"stamina"`
+	Speed          int            `json:"speed"`
+	Technique      int            `json:"technique"`
+	Mental         int            `json:"mental"`
+	MaxStamina     int            `json:"max_stamina"`
+	MaxSpeed       int            `json:"max_speed"`
+	MaxTechnique   int            `json:"max_technique"`
+	MaxMental      int            `json:"max_mental"`
+	Fatigue        int            `json:"fatigue"`
+	Morale         int            `json:"morale"`
+	FanSupport     int            `json:"fan_support"`
+	Money          int            `json:"money"`
+	Wins           int            `json:"wins"`
+	Races          int            `json:"races"`
+	IsRetired      bool           `json:"is_retired"`
+	CreatedAt      time.Time      `json:"created_at"`
+	Sex            Sex            `json:"sex"`
+	Aptitudes      Aptitudes      `json:"aptitudes"`
+	Pedigree       *Pedigree      `json:"pedigree,omitempty"`        // Only set for horses bred in the stable
+	EventHistory   []EventRecord  `json:"event_history,omitempty"`   // Decisions made during events
+	Skills         []string       `json:"skills,omitempty"`          // Learned skill IDs
+	EquippedSkills []string       `json:"equipped_skills,omitempty"` // Skill IDs in use, up to MaxSkillSlots
+	SkillHints     map[string]int `json:"skill_hints,omitempty"`     // Skill ID -> hints collected toward learning it
+	RecentTraining []TrainingType `json:"recent_training,omitempty"` // Latest training sessions, oldest first
+	LosingStreak   int            `json:"losing_streak,omitempty"`   // Races in a row finished outside the top 3
+	Rival          *Rivalry       `json:"rival,omitempty"`           // The horse fans see as this one's rival
+	FanHistory     []FanSnapshot  `json:"fan_history,omitempty"`     // Fan counts over time, oldest first
+	LastMeetGreet  int            `json:"last_meet_greet,omitempty"` // Week number of the latest meet-and-greet
+	RecentForm     []int          `json:"recent_form,omitempty"`     // Latest finishing positions, oldest first
+	Temperament    Temperament    `json:"temperament,omitempty"`     // How the horse copes with the starting gate
 }
 
 func NewHorse(name, breed string, baseStats Stats) *Horse {
@@ -48,68 +60,57 @@ func NewHorse(name, breed string, baseStats Stats) *Horse {
 		Fatigue:      0,
 		Morale:       100,
 		FanSupport:   0,
-		Money:        10000,
+		Money:        0, // Career prize earnings; spendable cash lives in the stable treasury
 		Wins:         0,
 		Races:        0,
 		IsRetired:    false,
 		CreatedAt:    time.Now(),
+		Temperament:  RandomTemperament(),
 	}
 }
 
-func (h *Horse) Train(trainingType TrainingType, supporters []Supporter) TrainingResult {
-	if h.Fatigue >= 80 {
+// TrainingFatigue is the fatigue a standard training session adds
+const TrainingFatigue = 15
+
+// MinTrainingFatigue is the least fatigue any session adds, however good the facilities
+const MinTrainingFatigue = 3
+
+// FatigueCutoff is the fatigue at which a horse is too tired to train
+const FatigueCutoff = 80
+
+// RestRecovery is the fatigue a rest day removes
+const RestRecovery = 30
+
+// RetirementAge is the age at which a horse stops racing
+const RetirementAge = 10
+
+func (h *Horse) Train(trainingType TrainingType, supporters []Supporter, facilities FacilityBonus) TrainingResult {
+	if h.Fatigue >= FatigueCutoff {
 		return TrainingResult{
 			Success: false,
 			Message: "Horse is too fatigued to train effectively!",
 		}
 	}
 
-	bonus := calculateSupporterBonus(supporters, trainingType)
-	baseGain := 10 + bonus
-
-	// Enhanced morale multiplier system
-	var moraleMultiplier float64
-	if h.Morale >= 100 {
-		moraleMultiplier = 1.20 // 20% bonus for excellent morale
-	} else if h.Morale >= 80 {
-		moraleMultiplier = 1.10 // 10% bonus for good morale
-	} else if h.Morale >= 60 {
-		moraleMultiplier = 1.00 // Normal training
-	} else if h.Morale >= 40 {
-		moraleMultiplier = 0.90 // 10% penalty for low morale
-	} else {
-		moraleMultiplier = 0.80 // 20% penalty for very low morale
-	}
-
-	actualGain := int(math.Round(float64(baseGain) * moraleMultiplier))
+	actualGain := h.PreviewTraining(trainingType, supporters, facilities).Gain
+	h.recordTraining(trainingType)
+	fatigue := facilities.SessionFatigue(trainingType)
 
 	// Track if stat was at max before training
-	wasAtMax := false
-	switch trainingType {
+	wasAtMax := h.IsStatMaxed(trainingType)
+	switch trainingType.Stat() {
 	case StaminaTraining:
-		wasAtMax = h.Stamina >= h.MaxStamina
-		if h.Stamina < h.MaxStamina {
-			h.Stamina = min(h.Stamina+actualGain, h.MaxStamina)
-		}
+		h.Stamina += actualGain
 	case SpeedTraining:
-		wasAtMax = h.Speed >= h.MaxSpeed
-		if h.Speed < h.MaxSpeed {
-			h.Speed = min(h.Speed+actualGain, h.MaxSpeed)
-		}
+		h.Speed += actualGain
 	case TechniqueTraining:
-		wasAtMax = h.Technique >= h.MaxTechnique
-		if h.Technique < h.MaxTechnique {
-			h.Technique = min(h.Technique+actualGain, h.MaxTechnique)
-		}
+		h.Technique += actualGain
 	case MentalTraining:
-		wasAtMax = h.Mental >= h.MaxMental
-		if h.Mental < h.MaxMental {
-			h.Mental = min(h.Mental+actualGain, h.MaxMental)
-		}
+		h.Mental += actualGain
 	}
 
 	// Apply fatigue
-	h.Fatigue += 15
+	h.Fatigue += fatigue
 	if h.Fatigue > 100 {
 		h.Fatigue = 100
 	}
@@ -122,27 +123,16 @@ func (h *Horse) Train(trainingType TrainingType, supporters []Supporter) Trainin
 		h.Morale = min(h.Morale+5, 100)
 	}
 
-	// Generate random training event
-	event := h.generateTrainingEvent()
-	result := TrainingResult{
+	return TrainingResult{
 		Success:     true,
 		Message:     "Training completed successfully!",
 		StatGain:    actualGain,
-		FatigueGain: 15,
-		Event:       event,
+		FatigueGain: fatigue,
 	}
-
-	// Apply event effects if any
-	if event != nil {
-		result.Message = event.Description
-		h.applyEventEffects(event.Effects)
-	}
-
-	return result
 }
 
 func (h *Horse) Rest() {
-	h.Fatigue = max(h.Fatigue-30, 0)
+	h.Fatigue = max(h.Fatigue-RestRecovery, 0)
 	h.Morale = min(h.Morale+10, 100)
 }
 
@@ -194,6 +184,88 @@ func (h *Horse) GetAgePerformanceFactor() float64 {
 	}
 }
 
+type Sex int
+
+const (
+	Stallion Sex = iota
+	Mare
+)
+
+func (s Sex) String() string {
+	switch s {
+	case Stallion:
+		return "Stallion"
+	case Mare:
+		return "Mare"
+	default:
+		return "Unknown"
+	}
+}
+
+func (s Sex) Icon() string {
+	if s == Mare {
+		return "♀"
+	}
+	return "♂"
+}
+
+// Aptitudes describe how well a horse handles each distance band (0-100).
+// A zero value means the horse has no recorded aptitudes and runs neutrally.
+type Aptitudes struct {
+	Sprint int `json:"sprint"` // up to 1400m
+	Mile   int `json:"mile"`   // up to 1800m
+	Medium int `json:"medium"` // up to 2400m
+	Long   int `json:"long"`   // beyond 2400m
+}
+
+func (a Aptitudes) IsZero() bool {
+	return a.Sprint == 0 && a.Mile == 0 && a.Medium == 0 && a.Long == 0
+}
+
+// ForDistance returns the aptitude value for a race distance
+func (a Aptitudes) ForDistance(distance int) int {
+	switch {
+	case distance <= 1400:
+		return a.Sprint
+	case distance <= 1800:
+		return a.Mile
+	case distance <= 2400:
+		return a.Medium
+	default:
+		return a.Long
+	}
+}
+
+// AptitudeGrade converts an aptitude value into an S-G letter grade
+func AptitudeGrade(value int) string {
+	switch {
+	case value >= 90:
+		return "S"
+	case value >= 80:
+		return "A"
+	case value >= 70:
+		return "B"
+	case value >= 60:
+		return "C"
+	case value >= 50:
+		return "D"
+	case value >= 40:
+		return "E"
+	case value >= 30:
+		return "F"
+	default:
+		return "G"
+	}
+}
+
+// GetDistanceFactor returns a speed multiplier (0.9-1.1) for the given race distance
+func (h *Horse) GetDistanceFactor(distance int) float64 {
+	if h.Aptitudes.IsZero() {
+		return 1.0
+	}
+	return 0.9 + float64(h.Aptitudes.ForDistance(distance))/500.0
+}
+
 type Stats struct {
 	Stamina   int `json:"stamina"`
 	Speed     int `json:"speed"`
@@ -208,8 +280,14 @@ const (
 	SpeedTraining
 	TechniqueTraining
 	MentalTraining
+	SwimmingTraining // Stamina work that is easy on the legs, unlocked by the pool
+	GatePractice     // Technique work out of the starting gate, unlocked by the starting gate
 )
 
+// AllTrainingTypes lists every training type, including ones unlocked by facilities
+var AllTrainingTypes = []TrainingType{StaminaTraining, SpeedTraining, TechniqueTraining, MentalTraining,
+	SwimmingTraining, GatePractice}
+
 func (t TrainingType) String() string {
 	switch t {
 	case StaminaTraining:
@@ -220,17 +298,50 @@ func (t TrainingType) String() string {
 		return "Technique"
 	case MentalTraining:
 		return "Mental"
+	case SwimmingTraining:
+		return "Swimming"
+	case GatePractice:
+		return "Gate Practice"
 	default:
 		return "Unknown"
 	}
 }
 
+// Stat returns the core training type whose stat this training improves
+func (t TrainingType) Stat() TrainingType {
+	switch t {
+	case SwimmingTraining:
+		return StaminaTraining
+	case GatePractice:
+		return TechniqueTraining
+	default:
+		return t
+	}
+}
+
+// BaseFatigue is the fatigue a session adds before facility reductions
+func (t TrainingType) BaseFatigue() int {
+	switch t {
+	case SwimmingTraining:
+		return 6
+	case GatePractice:
+		return 10
+	default:
+		return TrainingFatigue
+	}
+}
+
 type TrainingResult struct {
-	Success     bool   `json:"success"`
-	Message     string `json:"message"`
-	StatGain    int    `json:"stat_gain"`
-	FatigueGain int    `json:"fatigue_gain"`
-	Event       *Event `json:"event,omitempty"`
+	Success      bool     `json:"success"`
+	Message      string   `json:"message"`
+	StatGain     int      `json:"stat_gain"`
+	FatigueGain  int      `json:"fatigue_gain"`
+	Event        *Event   `json:"event,omitempty"`
+	LearnedSkill string   `json:"learned_skill,omitempty"`
+	Injured      bool     `json:"injured,omitempty"`
+	Friendship   []string `json:"friendship,omitempty"`     // Supporters who joined a friendship training burst
+	BondLevelUps []string `json:"bond_level_ups,omitempty"` // Supporters whose bond reached a new level
+	SkillHint    string   `json:"skill_hint,omitempty"`     // Progress toward a skill hinted at by the session's event
 }
 
 func generateID() string {
@@ -259,7 +370,7 @@ func max(a, b int) int {
 
 // IsStatMaxed checks if the given training type's stat is at maximum
 func (h *Horse) IsStatMaxed(trainingType TrainingType) bool {
-	switch trainingType {
+	switch trainingType.Stat() {
 	case StaminaTraining:
 		return h.Stamina >= h.MaxStamina
 	case SpeedTraining:
@@ -273,74 +384,8 @@ func (h *Horse) IsStatMaxed(trainingType TrainingType) bool {
 	}
 }
 
-// generateTrainingEvent creates random events during training
-func (h *Horse) generateTrainingEvent() *Event {
-	// 15% chance for an event to occur
-	if rand.Float64() > 0.15 {
-		return nil
-	}
-
-	events := []Event{
-		{
-			ID:          "good_weather",
-			Name:        "Good Weather Day",
-			Description: "☀️ Beautiful weather boosted your horse's spirits!",
-			Effects:     map[string]int{"morale": 10},
-		},
-		{
-			ID:          "friendly_visitor",
-			Name:        "Friendly Visitor",
-			Description: "👋 A friendly fan visited and cheered your horse on!",
-			Effects:     map[string]int{"morale": 15, "fan_support": 50},
-		},
-		{
-			ID:          "bad_weather",
-			Name:        "Bad Weather",
-			Description: "🌧️ Rain made training unpleasant...",
-			Effects:     map[string]int{"morale": -5},
-		},
-		{
-			ID:          "injury_scare",
-			Name:        "Minor Injury Scare",
-			Description: "😰 A stumble scared your horse, but no real injury!",
-			Effects:     map[string]int{"morale": -10, "fatigue": 10},
-		},
-		{
-			ID:          "great_workout",
-			Name:        "Excellent Training Session",
-			Description: "💪 Your horse felt amazing during training!",
-			Effects:     map[string]int{"morale": 8, "fatigue": -5},
-		},
-		{
-			ID:          "distracted",
-			Name:        "Distracted Training",
-			Description: "😵‍💫 Your horse seemed unfocused today...",
-			Effects:     map[string]int{"morale": -3},
-		},
-	}
-
-	// Weight events based on current horse condition
-	var weightedEvents []Event
-	for _, event := range events {
-		// Good events more likely with higher morale
-		if event.Effects["morale"] > 0 && h.Morale >= 70 {
-			weightedEvents = append(weightedEvents, event, event) // Double chance
-		} else if event.Effects["morale"] < 0 && h.Morale <= 40 {
-			weightedEvents = append(weightedEvents, event, event) // Double chance for bad events when morale is low
-		} else {
-			weightedEvents = append(weightedEvents, event)
-		}
-	}
-
-	if len(weightedEvents) == 0 {
-		return nil
-	}
-
-	selectedEvent := weightedEvents[rand.Intn(len(weightedEvents))]
-	return &selectedEvent
-}
-
-// applyEventEffects applies the effects of an event to the horse
+// applyEventEffects applies the effects of an event to the horse's stats and mood.
+// Fan support goes through GameState.applyEventEffects.
 func (h *Horse) applyEventEffects(effects map[string]int) {
 	for effect, value := range effects {
 		switch effect {
@@ -348,8 +393,6 @@ func (h *Horse) applyEventEffects(effects map[string]int) {
 			h.Morale = min(max(h.Morale+value, 20), 100)
 		case "fatigue":
 			h.Fatigue = min(max(h.Fatigue+value, 0), 100)
-		case "fan_support":
-			h.FanSupport = max(h.FanSupport+value, 0)
 		case "stamina":
 			h.Stamina = min(max(h.Stamina+value, 0), h.MaxStamina)
 		case "speed":
@@ -410,22 +453,17 @@ func (h *Horse) ApplyRaceResults(position int, totalEntrants int) {
 	h.Fatigue = min(h.Fatigue+25, 100)
 }
 
-// Dope increases all max stats by 50 and costs 5000
-func (h *Horse) Dope() bool {
-	const dopeCost = 5000
-	const maxStatIncrease = 50
+// DopeCost is the price of a doping treatment, paid from the stable treasury
+const DopeCost = 5000
 
-	if h.Money < dopeCost {
-		return false
-	}
+// Dope increases all max stats by 50
+func (h *Horse) Dope() {
+	const maxStatIncrease = 50
 
-	h.Money -= dopeCost
 	h.MaxStamina += maxStatIncrease
 	h.MaxSpeed += maxStatIncrease
 	h.MaxTechnique += maxStatIncrease
 	h.MaxMental += maxStatIncrease
-
-	return true
 }
 
 // AreAllStatsMaxed checks if all current stats are at their maximum
@@ -436,12 +474,11 @@ func (h *Horse) AreAllStatsMaxed() bool {
 		h.Mental >= h.MaxMental
 }
 
-func calculateSupporterBonus(supporters []Supporter, trainingType TrainingType) int {
-	bonus := 0
+// CalculateSupporterBonus is the deck's total training bonus for a training type, including synergies
+func CalculateSupporterBonus(supporters []Supporter, trainingType TrainingType) int {
+	bonus := SynergyBonus(supporters, trainingType)
 	for _, supporter := range supporters {
-		if supporter.TrainingBonus[trainingType] > 0 {
-			bonus += supporter.TrainingBonus[trainingType]
-		}
+		bonus += supporter.EffectiveBonus(trainingType)
 	}
 	return bonus
 }
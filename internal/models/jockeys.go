@@ -0,0 +1,246 @@
+package models
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// Jockey is a rider who can be booked to ride a horse in its races
+type Jockey struct {
+	ID        string
+	Name      string
+	Whip      int // 0-100, calms horses under the whip and lowers the chance of disobedience
+	Position  int // 0-100, how often the jockey steers into the best lane unprompted
+	Pace      int // 0-100, pace judgment that lifts speed over the second half of a race
+	RideFee   int // Charged each race when booked per ride
+	SeasonFee int // Charged once to retain the jockey for the rest of a season
+}
+
+// Riders maps horse IDs to the jockey riding each in a race
+type Riders map[string]Jockey
+
+var jockeyRoster = []Jockey{
+	{ID: "jky_001", Name: "Tom Ashby", Whip: 30, Position: 25, Pace: 30, RideFee: 150, SeasonFee: 1500},
+	{ID: "jky_002", Name: "Rosa Delgado", Whip: 45, Position: 40, Pace: 35, RideFee: 300, SeasonFee: 3000},
+	{ID: "jky_003", Name: "Kenji Mori", Whip: 35, Position: 60, Pace: 45, RideFee: 450, SeasonFee: 4500},
+	{ID: "jky_004", Name: "Ellie Burke", Whip: 65, Position: 45, Pace: 50, RideFee: 600, SeasonFee: 6000},
+	{ID: "jky_005", Name: "Marco Santini", Whip: 50, Position: 55, Pace: 70, RideFee: 900, SeasonFee: 9000},
+	{ID: "jky_006", Name: "Aoife Flynn", Whip: 75, Position: 70, Pace: 60, RideFee: 1400, SeasonFee: 14000},
+	{ID: "jky_007", Name: "Dmitri Volkov", Whip: 60, Position: 85, Pace: 80, RideFee: 2200, SeasonFee: 22000},
+	{ID: "jky_008", Name: "Grace Okafor", Whip: 90, Position: 90, Pace: 90, RideFee: 3500, SeasonFee: 35000},
+}
+
+var freelanceFirstNames = []string{"J.", "M.", "A.", "S.", "R.", "L.", "D.", "C.", "P.", "K."}
+var freelanceSurnames = []string{"Smith", "Moreau", "Tanaka", "O'Neill", "Costa", "Becker", "Hughes", "Nakamura",
+	"Ferreira", "Walsh", "Lindqvist", "Rossi", "Kowalski", "Dubois", "Reyes"}
+
+const (
+	// MaxRapport is the closest a jockey and horse can get
+	MaxRapport = 100
+	// rapportPerRide and rapportPerWin are how much rapport a race builds
+	rapportPerRide = 5
+	rapportPerWin  = 5
+)
+
+// JockeyTerms is how a jockey booking is paid for
+type JockeyTerms int
+
+const (
+	PerRide   JockeyTerms = iota // The ride fee is charged at every race
+	PerSeason                    // The season fee is paid upfront and covers every ride until the season ends
+)
+
+func (t JockeyTerms) String() string {
+	switch t {
+	case PerRide:
+		return "Per Ride"
+	case PerSeason:
+		return "Season Retainer"
+	default:
+		return "Unknown"
+	}
+}
+
+// JockeyBooking assigns a jockey to ride one horse
+type JockeyBooking struct {
+	HorseID  string      `json:"horse_id"`
+	JockeyID string      `json:"jockey_id"`
+	Terms    JockeyTerms `json:"terms"`
+	Season   int         `json:"season"` // Season a retainer was paid for
+}
+
+// AllJockeys returns every jockey available for hire
+func AllJockeys() []Jockey {
+	return jockeyRoster
+}
+
+// GetJockey looks up a jockey by ID
+func GetJockey(id string) (Jockey, bool) {
+	for _, jockey := range jockeyRoster {
+		if jockey.ID == id {
+			return jockey, true
+		}
+	}
+	return Jockey{}, false
+}
+
+// FreelanceJockey makes up a jockey for an NPC horse, better riders turning up for bigger races
+func FreelanceJockey(grade RaceGrade) Jockey {
+	skill := func() int {
+		return min(20+int(grade)*12+rand.Intn(25), 100)
+	}
+	return Jockey{
+		Name:     freelanceFirstNames[rand.Intn(len(freelanceFirstNames))] + " " + freelanceSurnames[rand.Intn(len(freelanceSurnames))],
+		Whip:     skill(),
+		Position: skill(),
+		Pace:     skill(),
+	}
+}
+
+// Booking returns the horse's jockey booking, if any
+func (gs *GameState) Booking(horseID string) *JockeyBooking {
+	for i := range gs.JockeyBookings {
+		if gs.JockeyBookings[i].HorseID == horseID {
+			return &gs.JockeyBookings[i]
+		}
+	}
+	return nil
+}
+
+// JockeyFor returns the jockey booked to ride the horse
+func (gs *GameState) JockeyFor(horseID string) (Jockey, bool) {
+	booking := gs.Booking(horseID)
+	if booking == nil {
+		return Jockey{}, false
+	}
+	return GetJockey(booking.JockeyID)
+}
+
+// BookJockey books a jockey for a horse. A season retainer is paid upfront; per-ride
+// fees are charged when the horse races.
+func (gs *GameState) BookJockey(horseID, jockeyID string, terms JockeyTerms) error {
+	jockey, ok := GetJockey(jockeyID)
+	if !ok {
+		return fmt.Errorf("jockey not found")
+	}
+	if current := gs.Booking(horseID); current != nil && current.Terms == PerSeason && current.Season == gs.Season.Number {
+		return fmt.Errorf("retained jockey is contracted until the season ends")
+	}
+
+	if terms == PerSeason {
+		if err := gs.Spend(JockeyExpense, fmt.Sprintf("Season retainer: %s", jockey.Name), jockey.SeasonFee, horseID); err != nil {
+			return err
+		}
+	}
+
+	gs.ReleaseJockey(horseID)
+	gs.JockeyBookings = append(gs.JockeyBookings, JockeyBooking{
+		HorseID:  horseID,
+		JockeyID: jockeyID,
+		Terms:    terms,
+		Season:   gs.Season.Number,
+	})
+	return nil
+}
+
+// ReleaseJockey ends a horse's jockey booking
+func (gs *GameState) ReleaseJockey(horseID string) {
+	for i, booking := range gs.JockeyBookings {
+		if booking.HorseID == horseID {
+			gs.JockeyBookings = append(gs.JockeyBookings[:i], gs.JockeyBookings[i+1:]...)
+			return
+		}
+	}
+}
+
+// RideFee returns what the horse's jockey charges for the next race
+func (gs *GameState) RideFee(horseID string) int {
+	booking := gs.Booking(horseID)
+	if booking == nil || booking.Terms == PerSeason {
+		return 0
+	}
+	jockey, _ := GetJockey(booking.JockeyID)
+	return jockey.RideFee
+}
+
+// ExpireRetainers ends the season retainers that have run out, announcing each one
+func (gs *GameState) ExpireRetainers() []string {
+	var messages []string
+	kept := gs.JockeyBookings[:0]
+	for _, booking := range gs.JockeyBookings {
+		if booking.Terms == PerSeason && booking.Season < gs.Season.Number {
+			jockey, _ := GetJockey(booking.JockeyID)
+			messages = append(messages, fmt.Sprintf("🏇 %s's season retainer has ended", jockey.Name))
+			continue
+		}
+		kept = append(kept, booking)
+	}
+	gs.JockeyBookings = kept
+	return messages
+}
+
+func rapportKey(jockeyID, horseID string) string {
+	return jockeyID + "/" + horseID
+}
+
+// Rapport returns how well a jockey knows a horse, from 0 to MaxRapport
+func (gs *GameState) Rapport(jockeyID, horseID string) int {
+	return gs.JockeyRapport[rapportKey(jockeyID, horseID)]
+}
+
+// RecordRide builds rapport between the horse and its jockey after a race
+func (gs *GameState) RecordRide(horseID string, position int) {
+	booking := gs.Booking(horseID)
+	if booking == nil {
+		return
+	}
+	if gs.JockeyRapport == nil {
+		gs.JockeyRapport = make(map[string]int)
+	}
+
+	gain := rapportPerRide
+	if position == 1 {
+		gain += rapportPerWin
+	}
+	key := rapportKey(booking.JockeyID, horseID)
+	gs.JockeyRapport[key] = min(gs.JockeyRapport[key]+gain, MaxRapport)
+}
+
+// WithRapport returns the jockey as they ride a horse they know: up to 20% better at everything
+func (j Jockey) WithRapport(rapport int) Jockey {
+	lift := func(attribute int) int {
+		return min(attribute+attribute*rapport/(MaxRapport*5), 100)
+	}
+	j.Whip = lift(j.Whip)
+	j.Position = lift(j.Position)
+	j.Pace = lift(j.Pace)
+	return j
+}
+
+// RidingJockey returns the horse's jockey with their rapport with the horse applied
+func (gs *GameState) RidingJockey(horseID string) (Jockey, bool) {
+	jockey, ok := gs.JockeyFor(horseID)
+	if !ok {
+		return Jockey{}, false
+	}
+	return jockey.WithRapport(gs.Rapport(jockey.ID, horseID)), true
+}
+
+// DisobedienceChance returns the chance the horse turns disobedient under the whip,
+// lowered by up to half by a skilled jockey
+func (gs *GameState) DisobedienceChance(horse *Horse, whipUses int) float64 {
+	chance := horse.CalculateDisobedienceChance(whipUses)
+	if jockey, ok := gs.RidingJockey(horse.ID); ok {
+		chance *= 1.0 - float64(jockey.Whip)/200.0
+	}
+	return chance
+}
+
+// PaceFactor returns the speed multiplier from a jockey's pace judgment, up to +8%
+// over the second half of a race
+func PaceFactor(pace int, progress float64) float64 {
+	if progress <= 0.5 {
+		return 1.0
+	}
+	return 1.0 + float64(pace)*0.0008
+}
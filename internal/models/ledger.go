@@ -0,0 +1,198 @@
+package models
+
+import "fmt"
+
+// StartingTreasury is the stable's cash at the start of a new game
+const StartingTreasury = 10000
+
+type LedgerCategory int
+
+const (
+	EntryFeeExpense LedgerCategory = iota
+	PrizeIncome
+	SpaExpense
+	DopingExpense
+	PurchaseExpense
+	PassiveIncome
+	UpkeepExpense
+	StudFeeExpense
+	OpeningBalance
+	FacilityExpense
+	SponsorIncome
+	SponsorPenalty
+	JockeyExpense
+)
+
+// LastLedgerCategory is the highest ledger category, for iterating over all of them
+const LastLedgerCategory = JockeyExpense
+
+func (c LedgerCategory) String() string {
+	switch c {
+	case EntryFeeExpense:
+		return "Entry Fees"
+	case PrizeIncome:
+		return "Prize Money"
+	case SpaExpense:
+		return "Spa"
+	case DopingExpense:
+		return "Doping"
+	case PurchaseExpense:
+		return "Purchases"
+	case PassiveIncome:
+		return "Passive Income"
+	case UpkeepExpense:
+		return "Upkeep"
+	case StudFeeExpense:
+		return "Stud Fees"
+	case OpeningBalance:
+		return "Opening Balance"
+	case FacilityExpense:
+		return "Facilities"
+	case SponsorIncome:
+		return "Sponsorship"
+	case SponsorPenalty:
+		return "Sponsor Penalties"
+	case JockeyExpense:
+		return "Jockeys"
+	default:
+		return "Other"
+	}
+}
+
+// LedgerEntry records a single transaction against the stable treasury
+type LedgerEntry struct {
+	Season      int            `json:"season"`
+	Week        int            `json:"week"`
+	Category    LedgerCategory `json:"category"`
+	Description string         `json:"description"`
+	Amount      int            `json:"amount"`  // Positive for income, negative for expenses
+	Balance     int            `json:"balance"` // Treasury balance after the transaction
+	HorseID     string         `json:"horse_id,omitempty"`
+}
+
+// SeasonFinances summarises a season's income and expenses by category
+type SeasonFinances struct {
+	Season   int                    `json:"season"`
+	Income   map[LedgerCategory]int `json:"income"`
+	Expenses map[LedgerCategory]int `json:"expenses"`
+}
+
+// TotalIncome returns the season's total income
+func (f SeasonFinances) TotalIncome() int {
+	total := 0
+	for _, amount := range f.Income {
+		total += amount
+	}
+	return total
+}
+
+// TotalExpenses returns the season's total expenses as a positive number
+func (f SeasonFinances) TotalExpenses() int {
+	total := 0
+	for _, amount := range f.Expenses {
+		total += amount
+	}
+	return total
+}
+
+// NetProfit returns income minus expenses for the season
+func (f SeasonFinances) NetProfit() int {
+	return f.TotalIncome() - f.TotalExpenses()
+}
+
+// CanAfford checks if the treasury can cover an expense
+func (gs *GameState) CanAfford(amount int) bool {
+	return gs.Treasury >= amount
+}
+
+// Spend charges an expense to the treasury, failing if funds are insufficient
+func (gs *GameState) Spend(category LedgerCategory, description string, amount int, horseID string) error {
+	if !gs.CanAfford(amount) {
+		return fmt.Errorf("insufficient funds")
+	}
+	gs.recordTransaction(category, description, -amount, horseID)
+	return nil
+}
+
+// Charge deducts an unavoidable expense, such as upkeep, even if it overdraws the treasury
+func (gs *GameState) Charge(category LedgerCategory, description string, amount int, horseID string) {
+	gs.recordTransaction(category, description, -amount, horseID)
+}
+
+// Earn adds income to the treasury
+func (gs *GameState) Earn(category LedgerCategory, description string, amount int, horseID string) {
+	gs.recordTransaction(category, description, amount, horseID)
+}
+
+func (gs *GameState) recordTransaction(category LedgerCategory, description string, amount int, horseID string) {
+	if amount == 0 {
+		return
+	}
+
+	gs.Treasury += amount
+	gs.Ledger = append(gs.Ledger, LedgerEntry{
+		Season:      gs.Season.Number,
+		Week:        gs.Season.CurrentWeek,
+		Category:    category,
+		Description: description,
+		Amount:      amount,
+		Balance:     gs.Treasury,
+		HorseID:     horseID,
+	})
+}
+
+// GetSeasonFinances builds the profit and loss summary for a season from the ledger
+func (gs *GameState) GetSeasonFinances(season int) SeasonFinances {
+	finances := SeasonFinances{
+		Season:   season,
+		Income:   make(map[LedgerCategory]int),
+		Expenses: make(map[LedgerCategory]int),
+	}
+
+	for _, entry := range gs.Ledger {
+		if entry.Season != season || entry.Category == OpeningBalance {
+			continue
+		}
+		if entry.Amount > 0 {
+			finances.Income[entry.Category] += entry.Amount
+		} else {
+			finances.Expenses[entry.Category] -= entry.Amount
+		}
+	}
+
+	return finances
+}
+
+// MigrateTreasury moves cash held by horses in older saves into the stable treasury.
+// Money then only counts career prize earnings, rebuilt from the race results kept.
+func (gs *GameState) MigrateTreasury() {
+	if len(gs.Ledger) > 0 {
+		return
+	}
+
+	opening := 0
+	for _, horse := range gs.Stable {
+		opening += horse.Money
+		horse.Money = gs.prizeEarnings(horse.ID)
+	}
+	if opening == 0 && len(gs.Stable) == 0 {
+		opening = StartingTreasury
+	}
+	for i := range gs.AvailableHorses {
+		gs.AvailableHorses[i].Money = 0
+	}
+
+	gs.Treasury = 0
+	gs.Earn(OpeningBalance, "Opening balance", opening, "")
+}
+
+// prizeEarnings totals the prize money in a horse's stored race results
+func (gs *GameState) prizeEarnings(horseID string) int {
+	total := 0
+	for _, result := range gs.Season.RaceResults {
+		if result.HorseID == horseID {
+			total += result.PrizeMoney
+		}
+	}
+	return total
+}
@@ -0,0 +1,81 @@
+package models
+
+import "fmt"
+
+// StrongestTrainingType returns the training type matching the horse's highest stat
+func (h *Horse) StrongestTrainingType() TrainingType {
+	best := StaminaTraining
+	bestValue := h.Stamina
+	if h.Speed > bestValue {
+		best, bestValue = SpeedTraining, h.Speed
+	}
+	if h.Technique > bestValue {
+		best, bestValue = TechniqueTraining, h.Technique
+	}
+	if h.Mental > bestValue {
+		best = MentalTraining
+	}
+	return best
+}
+
+// MentorBonus calculates the training bonus a retired mentor passes on,
+// scaled by its career highlights and the tier of its retirement home
+func (r RetiredHorse) MentorBonus() int {
+	bonus := 2
+	bonus += min(r.CareerHighlights.TotalWins/3, 4)       // Up to +4 for a winning career
+	bonus += min(r.CareerHighlights.HighestRating/150, 2) // Up to +2 for a top-rated horse
+
+	tierMultiplier := 1.0 + 0.25*float64(max(r.RetirementHome.Tier-1, 0))
+	return int(float64(bonus) * tierMultiplier)
+}
+
+// AsSupporter presents a retired mentor as a supporter for training purposes
+func (r RetiredHorse) AsSupporter() Supporter {
+	rarity := Rarity(min(max(r.RetirementHome.Tier-1, 0), int(UltraRare)))
+	return Supporter{
+		ID:          "mentor_" + r.Horse.ID,
+		Name:        r.Horse.Name + " (Mentor)",
+		Rarity:      rarity,
+		Description: fmt.Sprintf("Retired champion mentoring from %s", r.RetirementHome.Name),
+		TrainingBonus: map[TrainingType]int{
+			r.Horse.StrongestTrainingType(): r.MentorBonus(),
+		},
+		SpecialEffect: "mentor",
+		IsOwned:       true,
+	}
+}
+
+// GetMentorSupporters returns retired training mentors as supporters
+func (gs *GameState) GetMentorSupporters() []Supporter {
+	var mentors []Supporter
+	for _, retired := range gs.RetiredHorses {
+		if retired.PostRetirementRole == TrainingMentor {
+			mentors = append(mentors, retired.AsSupporter())
+		}
+	}
+	return mentors
+}
+
+// GetTrainingSupporters returns the active supporters plus any training mentors.
+// Mentors do not count toward the four supporter limit.
+func (gs *GameState) GetTrainingSupporters() []Supporter {
+	return append(gs.GetActiveSupporters(), gs.GetMentorSupporters()...)
+}
+
+// GetTrainingSupportersFor returns the supporters and mentors that train with a stable horse
+func (gs *GameState) GetTrainingSupportersFor(horseID string) []Supporter {
+	if horseID == gs.ActiveHorseID {
+		return gs.GetTrainingSupporters()
+	}
+
+	var supporters []Supporter
+	for _, supporter := range gs.Supporters {
+		for _, id := range gs.HorseSupporters[horseID] {
+			if supporter.ID == id {
+				supporters = append(supporters, supporter)
+				break
+			}
+		}
+	}
+	return append(supporters, gs.GetMentorSupporters()...)
+}
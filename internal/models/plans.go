@@ -0,0 +1,288 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DaysPerWeek is the number of training days in a week
+const DaysPerWeek = 6
+
+// PlanDay is one day of a training plan
+type PlanDay struct {
+	Rest         bool         `json:"rest"`
+	TrainingType TrainingType `json:"training_type"`
+}
+
+func (d PlanDay) String() string {
+	if d.Rest {
+		return "Rest"
+	}
+	return d.TrainingType.String()
+}
+
+// TrainingPlan is a reusable template for a week of training and rest days
+type TrainingPlan struct {
+	Name    string    `json:"name"`
+	Days    []PlanDay `json:"days"`
+	BuiltIn bool      `json:"-"`
+}
+
+// Summary lists the plan's days, e.g. "Speed, Speed, Rest, ..."
+func (p TrainingPlan) Summary() string {
+	parts := make([]string, 0, len(p.Days))
+	for _, day := range p.Days {
+		parts = append(parts, day.String())
+	}
+	return strings.Join(parts, ", ")
+}
+
+// ScheduledPlan assigns a training plan to one week of a horse's season
+type ScheduledPlan struct {
+	HorseID string       `json:"horse_id"`
+	Season  int          `json:"season"`
+	Week    int          `json:"week"`
+	Plan    TrainingPlan `json:"plan"`
+}
+
+func trainDay(trainingType TrainingType) PlanDay {
+	return PlanDay{TrainingType: trainingType}
+}
+
+var restDay = PlanDay{Rest: true}
+
+// DefaultTrainingPlans returns the built-in plan templates
+func DefaultTrainingPlans() []TrainingPlan {
+	return []TrainingPlan{
+		{Name: "Sprinter build", BuiltIn: true, Days: []PlanDay{
+			trainDay(SpeedTraining), trainDay(SpeedTraining), trainDay(TechniqueTraining),
+			restDay, trainDay(SpeedTraining), trainDay(MentalTraining)}},
+		{Name: "Stayer build", BuiltIn: true, Days: []PlanDay{
+			trainDay(StaminaTraining), trainDay(StaminaTraining), trainDay(MentalTraining),
+			restDay, trainDay(StaminaTraining), trainDay(SpeedTraining)}},
+		{Name: "Balanced", BuiltIn: true, Days: []PlanDay{
+			trainDay(StaminaTraining), trainDay(SpeedTraining), restDay,
+			trainDay(TechniqueTraining), trainDay(MentalTraining), restDay}},
+		{Name: "Recovery week", BuiltIn: true, Days: []PlanDay{
+			restDay, trainDay(MentalTraining), restDay,
+			restDay, trainDay(TechniqueTraining), restDay}},
+	}
+}
+
+// AllTrainingPlans returns the built-in plans followed by the player's saved plans
+func (gs *GameState) AllTrainingPlans() []TrainingPlan {
+	return append(DefaultTrainingPlans(), gs.TrainingPlans...)
+}
+
+// SaveTrainingPlan stores a plan template, replacing a saved plan with the same name
+func (gs *GameState) SaveTrainingPlan(plan TrainingPlan) error {
+	plan.Name = strings.TrimSpace(plan.Name)
+	if plan.Name == "" {
+		return fmt.Errorf("plan needs a name")
+	}
+	if len(plan.Days) != DaysPerWeek {
+		return fmt.Errorf("plan must cover %d days", DaysPerWeek)
+	}
+	for _, builtIn := range DefaultTrainingPlans() {
+		if strings.EqualFold(builtIn.Name, plan.Name) {
+			return fmt.Errorf("%s is a built-in plan", builtIn.Name)
+		}
+	}
+
+	plan.BuiltIn = false
+	for i, saved := range gs.TrainingPlans {
+		if strings.EqualFold(saved.Name, plan.Name) {
+			gs.TrainingPlans[i] = plan
+			return nil
+		}
+	}
+	gs.TrainingPlans = append(gs.TrainingPlans, plan)
+	return nil
+}
+
+// DeleteTrainingPlan removes a saved plan template
+func (gs *GameState) DeleteTrainingPlan(name string) error {
+	for _, builtIn := range DefaultTrainingPlans() {
+		if strings.EqualFold(builtIn.Name, name) {
+			return fmt.Errorf("built-in plans cannot be deleted")
+		}
+	}
+
+	for i, saved := range gs.TrainingPlans {
+		if strings.EqualFold(saved.Name, name) {
+			gs.TrainingPlans = append(gs.TrainingPlans[:i], gs.TrainingPlans[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("plan not found")
+}
+
+// PlanFromCurrentWeek builds a plan from a horse's training this week; unused days become rest
+func (gs *GameState) PlanFromCurrentWeek(horseID, name string) TrainingPlan {
+	plan := TrainingPlan{Name: name, Days: make([]PlanDay, DaysPerWeek)}
+	for i := range plan.Days {
+		plan.Days[i] = restDay
+	}
+	for _, day := range gs.Season.GetHorseTrainingDays(horseID) {
+		if day.IsCompleted && !day.IsRest && day.Day < DaysPerWeek {
+			plan.Days[day.Day] = trainDay(day.TrainingType)
+		}
+	}
+	return plan
+}
+
+// SchedulePlan assigns a plan to a horse for a range of weeks this season
+func (gs *GameState) SchedulePlan(horseID string, plan TrainingPlan, fromWeek, toWeek int) error {
+	if fromWeek < gs.Season.CurrentWeek || toWeek > gs.Season.MaxWeeks || fromWeek > toWeek {
+		return fmt.Errorf("weeks must be between %d and %d", gs.Season.CurrentWeek, gs.Season.MaxWeeks)
+	}
+	if len(plan.Days) != DaysPerWeek {
+		return fmt.Errorf("plan must cover %d days", DaysPerWeek)
+	}
+
+	// Drop plans from past seasons and any this range replaces
+	kept := gs.PlanSchedule[:0]
+	for _, scheduled := range gs.PlanSchedule {
+		if scheduled.Season != gs.Season.Number {
+			continue
+		}
+		if scheduled.HorseID == horseID && scheduled.Week >= fromWeek && scheduled.Week <= toWeek {
+			continue
+		}
+		kept = append(kept, scheduled)
+	}
+	gs.PlanSchedule = kept
+
+	for week := fromWeek; week <= toWeek; week++ {
+		gs.PlanSchedule = append(gs.PlanSchedule, ScheduledPlan{
+			HorseID: horseID,
+			Season:  gs.Season.Number,
+			Week:    week,
+			Plan:    plan,
+		})
+	}
+	return nil
+}
+
+// GetScheduledPlan returns the plan scheduled for a horse in a week of the current season
+func (gs *GameState) GetScheduledPlan(horseID string, week int) *TrainingPlan {
+	for i := range gs.PlanSchedule {
+		scheduled := &gs.PlanSchedule[i]
+		if scheduled.HorseID == horseID && scheduled.Season == gs.Season.Number && scheduled.Week == week {
+			return &scheduled.Plan
+		}
+	}
+	return nil
+}
+
+// AutoSchedule fits a plan to the horse's fatigue: a training day becomes rest whenever
+// the session would push fatigue over the training cutoff. Days in done are skipped.
+func AutoSchedule(plan TrainingPlan, fatigue int, done map[int]bool, sessionFatigue func(TrainingType) int) []PlanDay {
+	days := make([]PlanDay, len(plan.Days))
+	for i, day := range plan.Days {
+		if done[i] {
+			days[i] = day
+			continue
+		}
+		if !day.Rest && fatigue+sessionFatigue(day.TrainingType) >= FatigueCutoff {
+			day = restDay
+		}
+		days[i] = day
+
+		if day.Rest {
+			fatigue = max(fatigue-RestRecovery, 0)
+		} else {
+			fatigue = min(fatigue+sessionFatigue(day.TrainingType), 100)
+		}
+	}
+	return days
+}
+
+// completedDays returns which days of the current week a horse has already used
+func (gs *GameState) completedDays(horseID string) map[int]bool {
+	done := make(map[int]bool)
+	for _, day := range gs.Season.GetHorseTrainingDays(horseID) {
+		if day.IsCompleted {
+			done[day.Day] = true
+		}
+	}
+	return done
+}
+
+// PreviewPlan shows how a plan would play out over the horse's remaining days this week
+func (gs *GameState) PreviewPlan(horse *Horse, plan TrainingPlan) []PlanDay {
+	return AutoSchedule(plan, horse.Fatigue, gs.completedDays(horse.ID), gs.SessionFatigue)
+}
+
+// SessionFatigue returns the fatigue a session adds with the stable's facilities
+func (gs *GameState) SessionFatigue(trainingType TrainingType) int {
+	return gs.FacilityBonus(trainingType).SessionFatigue(trainingType)
+}
+
+// FollowPlan trains or rests a horse on each of its remaining days this week as the plan says,
+// resting instead of training when fatigue would cross the cutoff. It stops early when a
+// training event needs a decision, returning that event with the days completed so far.
+func (gs *GameState) FollowPlan(horse *Horse, plan TrainingPlan) ([]TrainingDay, *Event) {
+	var completed []TrainingDay
+	done := gs.completedDays(horse.ID)
+
+	for i, planned := range plan.Days {
+		if done[i] {
+			continue
+		}
+
+		day := TrainingDay{
+			HorseID:     horse.ID,
+			Week:        gs.Season.CurrentWeek,
+			Day:         i,
+			IsCompleted: true,
+		}
+		if planned.Rest || horse.Fatigue+gs.SessionFatigue(planned.TrainingType) >= FatigueCutoff {
+			horse.Rest()
+			day.IsRest = true
+		} else {
+			day.TrainingType = planned.TrainingType
+			// Fetched each day so bonds grown earlier in the week count
+			supporters := gs.GetTrainingSupportersFor(horse.ID)
+			result := gs.TrainHorse(horse, planned.TrainingType, supporters)
+			day.Result = &result
+		}
+
+		gs.Season.AddTrainingDay(day)
+		completed = append(completed, day)
+
+		if day.Result != nil && day.Result.Event != nil && day.Result.Event.HasChoices() {
+			return completed, day.Result.Event
+		}
+	}
+
+	return completed, nil
+}
+
+// followScheduledPlan runs a horse's scheduled plan for the week before it is closed out.
+// It stops at the first event that needs a decision, leaving it pending and the rest of the
+// week for the player to resume, and reports whether it stopped.
+func (gs *GameState) followScheduledPlan(horse *Horse) bool {
+	plan := gs.GetScheduledPlan(horse.ID, gs.Season.CurrentWeek)
+	if plan == nil {
+		return false
+	}
+
+	days, event := gs.FollowPlan(horse, *plan)
+	for _, day := range days {
+		if day.Result != nil {
+			for _, levelUp := range day.Result.BondLevelUps {
+				gs.AddNotice(fmt.Sprintf("💞 Bond up! %s", levelUp))
+			}
+		}
+	}
+	if event == nil {
+		if len(days) > 0 {
+			gs.AddNotice(fmt.Sprintf("📋 %s followed the %s plan", horse.Name, plan.Name))
+		}
+		return false
+	}
+
+	gs.AddNotice(fmt.Sprintf("⏸️ %s's %s plan is waiting on a decision; finish the week once it's settled", horse.Name, plan.Name))
+	return true
+}
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAutoSchedule(t *testing.T) {
+	s, r := trainDay(SpeedTraining), restDay
+	flat := func(TrainingType) int { return TrainingFatigue }
+
+	tests := []struct {
+		name    string
+		plan    []PlanDay
+		fatigue int
+		done    map[int]bool
+		want    []PlanDay
+	}{
+		{"a fresh horse trains until the cutoff", []PlanDay{s, s, s, s, s, s}, 0, nil, []PlanDay{s, s, s, s, s, r}},
+		{"a tired horse rests first", []PlanDay{s, s, s, s, s, s}, 70, nil, []PlanDay{r, s, s, r, s, s}},
+		{"used days are kept and don't add fatigue", []PlanDay{s, s, s, s, s, s}, 70, map[int]bool{0: true}, []PlanDay{s, r, s, s, r, s}},
+		{"planned rest days stay rest", []PlanDay{r, s, r}, 0, nil, []PlanDay{r, s, r}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := AutoSchedule(TrainingPlan{Days: tt.plan}, tt.fatigue, tt.done, flat)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AutoSchedule() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
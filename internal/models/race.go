@@ -2,6 +2,7 @@ package models
 
 import (
 	"math/rand"
+	"sort"
 	"time"
 )
 
@@ -14,7 +15,47 @@ type Race struct {
 	MinRating   int       `json:"min_rating"`
 	MaxEntrants int       `json:"max_entrants"`
 	Date        time.Time `json:"date"`
-	Entrants    []string  `json:"entrants"` // Horse IDs
+	Entrants    []string  `json:"entrants"`           // Horse IDs
+	Weather     Weather   `json:"weather"`            // Drawn when the race is run
+	MinFans     int       `json:"min_fans,omitempty"` // Fans needed for an invitation, 0 for open races
+	Weighting   Weighting `json:"weighting,omitempty"`
+	Weights     WeightMap `json:"weights,omitempty"` // Assigned when the race is run
+	Course      Course    `json:"course,omitempty"`
+	Draw        GateDraw  `json:"draw,omitempty"` // Drawn when the race is run
+}
+
+type Weather int
+
+const (
+	Sunny Weather = iota
+	Cloudy
+	Rainy
+)
+
+func (w Weather) String() string {
+	switch w {
+	case Sunny:
+		return "☀️ Sunny"
+	case Cloudy:
+		return "☁️ Cloudy"
+	case Rainy:
+		return "🌧️ Rainy"
+	default:
+		return "Unknown"
+	}
+}
+
+// RandomWeather draws race day weather
+func RandomWeather() Weather {
+	roll := rand.Float64()
+	switch {
+	case roll < 0.5:
+		return Sunny
+	case roll < 0.8:
+		return Cloudy
+	default:
+		return Rainy
+	}
 }
 
 type RaceGrade int
@@ -108,19 +149,66 @@ type RaceEntrant struct {
 	Position  int    `json:"position"`
 	Time      string `json:"time"`
 	Distance  int    `json:"distance"`
+	Weight    int    `json:"weight,omitempty"` // Weight carried in kg
+	Jockey    string `json:"jockey,omitempty"`
+	Stall     int    `json:"stall,omitempty"` // Starting stall, from 1 on the inside
+	Break     Break  `json:"break,omitempty"` // How the horse left the gate
 }
 
 type RaceProgressUpdate struct {
-	Turn       int            `json:"turn"`
-	Positions  map[string]int `json:"positions"` // HorseID -> position
-	Distances  map[string]int `json:"distances"` // HorseID -> distance covered
-	Commentary string         `json:"commentary"`
-	Events     []string       `json:"events"`
+	Turn             int            `json:"turn"`
+	Positions        map[string]int `json:"positions"` // HorseID -> position
+	Distances        map[string]int `json:"distances"` // HorseID -> distance covered
+	Commentary       string         `json:"commentary"`
+	Events           []string       `json:"events"`
+	SkillActivations []string       `json:"skill_activations,omitempty"`
+	Cheers           []string       `json:"cheers,omitempty"` // Lines called out by the player's supporters
+	Energy           EnergyMap      `json:"energy,omitempty"` // HorseID -> reserves after the turn
+}
+
+// RaceResultKey is the key a horse's result in a race is stored under
+func RaceResultKey(raceID, horseID string) string {
+	return raceID + "/" + horseID
+}
+
+// RecordRaceResult stores a horse's result in a race, listing the race once however many
+// stable horses run it
+func (s *Season) RecordRaceResult(result CompletedRaceResult) {
+	if s.RaceResults == nil {
+		s.RaceResults = make(map[string]CompletedRaceResult)
+	}
+	s.RaceResults[RaceResultKey(result.RaceID, result.HorseID)] = result
+
+	for _, raceID := range s.CompletedRaces {
+		if raceID == result.RaceID {
+			return
+		}
+	}
+	s.CompletedRaces = append(s.CompletedRaces, result.RaceID)
+}
+
+// ResultsFor returns the stable's results in a race, best finish first
+func (s Season) ResultsFor(raceID string) []CompletedRaceResult {
+	var results []CompletedRaceResult
+	for _, result := range s.RaceResults {
+		if result.RaceID == raceID {
+			results = append(results, result)
+		}
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Position != results[j].Position {
+			return results[i].Position < results[j].Position
+		}
+		return results[i].HorseName < results[j].HorseName
+	})
+	return results
 }
 
 // CompletedRaceResult represents a historical race result for season tracking
 type CompletedRaceResult struct {
 	RaceID        string    `json:"race_id"`
+	HorseID       string    `json:"horse_id"`
+	HorseName     string    `json:"horse_name"`
 	RaceName      string    `json:"race_name"`
 	Grade         RaceGrade `json:"grade"`
 	Distance      int       `json:"distance"`
@@ -129,6 +217,7 @@ type CompletedRaceResult struct {
 	TotalEntrants int       `json:"total_entrants"`
 	PrizeMoney    int       `json:"prize_money"`
 	FansGained    int       `json:"fans_gained"`
+	WeightCarried int       `json:"weight_carried,omitempty"` // In kg
 }
 
 func NewRace(name string, distance int, grade RaceGrade, prize int, minRating int) *Race {
@@ -163,6 +252,17 @@ func (r *Race) GetEntryFee() int {
 	}
 }
 
+// FieldSize returns how many horses line up when the race is run
+func (r *Race) FieldSize() int {
+	return min(r.MaxEntrants, 8)
+}
+
+// RivalRating returns the stat level of the AI rival drawn into an entry slot
+func (r *Race) RivalRating(slot int) int {
+	baseRating := r.MinRating + (r.MinRating / 4)
+	return baseRating + (-10 + (slot * 5))
+}
+
 func (r *Race) CanEnter(horse *Horse) bool {
 	if horse.IsRetired {
 		return false
@@ -183,6 +283,11 @@ func (r *Race) CanEnterWithGameState(horse *Horse, gameState *GameState) bool {
 		return false
 	}
 
+	// Invitational races are entered on popularity rather than progression
+	if r.MinFans > 0 {
+		return horse.FanSupport >= r.MinFans
+	}
+
 	// Additional progression checks
 	return r.MeetsProgressionRequirements(gameState)
 }
@@ -0,0 +1,272 @@
+package models
+
+import "fmt"
+
+// MaxSkillSlots is how many learned skills a horse can take into a race
+const MaxSkillSlots = 3
+
+// SkillTrainingMilestone is the stat value that teaches a horse its training skill
+const SkillTrainingMilestone = 200
+
+// SkillHintsToLearn is how many hints for a skill, mostly from supporter events, teach it to a horse
+const SkillHintsToLearn = 3
+
+type SkillTrigger int
+
+const (
+	TriggerStartDash    SkillTrigger = iota // Opening stretch of the race
+	TriggerFinalStretch                     // Final stretch of the race
+	TriggerCorner                           // In a corner while near the front
+	TriggerRain                             // Racing on a rainy track
+	TriggerLowStamina                       // Running low on race stamina
+	TriggerTrailing                         // Behind the pack after halfway
+	TriggerLeading                          // In front of the field
+)
+
+// Skill is an ability a horse can equip that boosts its speed when its trigger is met
+type Skill struct {
+	ID          string       `json:"id"`
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	Icon        string       `json:"icon"`
+	Trigger     SkillTrigger `json:"trigger"`
+	Boost       float64      `json:"boost"`  // Speed multiplier while active
+	Unlock      string       `json:"unlock"` // How the skill is learned
+}
+
+// RaceSituation describes a horse's state on a race turn for skill activation checks
+type RaceSituation struct {
+	Progress     float64 // 0.0 at the start, 1.0 at the finish
+	Position     int
+	FieldSize    int
+	StaminaRatio float64 // Aerobic energy left as a fraction of the starting reserve
+	Weather      Weather
+}
+
+var skillCatalog = []Skill{
+	{ID: "quick_start", Name: "Quick Start", Icon: "🚀", Trigger: TriggerStartDash, Boost: 1.12,
+		Description: "Bursts out of the gate over the opening stretch",
+		Unlock:      "Win a race with the Lead formation"},
+	{ID: "final_spurt", Name: "Final Spurt", Icon: "⚡", Trigger: TriggerFinalStretch, Boost: 1.15,
+		Description: "Finds another gear in the final stretch",
+		Unlock:      "Train Speed to 200"},
+	{ID: "inner_rail", Name: "Inner Rail Specialist", Icon: "🌀", Trigger: TriggerCorner, Boost: 1.10,
+		Description: "Hugs the rail through the corners when near the front",
+		Unlock:      "Train Technique to 200"},
+	{ID: "rain_lover", Name: "Rain Lover", Icon: "🌧️", Trigger: TriggerRain, Boost: 1.08,
+		Description: "Relishes a wet track",
+		Unlock:      "Place in the top 3 on a rainy track"},
+	{ID: "iron_will", Name: "Iron Will", Icon: "🛡️", Trigger: TriggerLowStamina, Boost: 1.20,
+		Description: "Refuses to tire when stamina runs low",
+		Unlock:      "Train Stamina to 200"},
+	{ID: "never_say_die", Name: "Never Say Die", Icon: "🔥", Trigger: TriggerTrailing, Boost: 1.12,
+		Description: "Charges through the field when behind after halfway",
+		Unlock:      "Train Mental to 200"},
+	{ID: "front_runner", Name: "Front Runner", Icon: "🏁", Trigger: TriggerLeading, Boost: 1.05,
+		Description: "Grows stronger with the lead",
+		Unlock:      "Win 5 races"},
+}
+
+// trainingSkills maps training milestones to the skill they teach
+var trainingSkills = map[TrainingType]string{
+	StaminaTraining:   "iron_will",
+	SpeedTraining:     "final_spurt",
+	TechniqueTraining: "inner_rail",
+	MentalTraining:    "never_say_die",
+}
+
+// AllSkills returns every skill in the game
+func AllSkills() []Skill {
+	return skillCatalog
+}
+
+// GetSkill looks up a skill by ID
+func GetSkill(skillID string) (Skill, bool) {
+	for _, skill := range skillCatalog {
+		if skill.ID == skillID {
+			return skill, true
+		}
+	}
+	return Skill{}, false
+}
+
+// IsActive checks if the skill's trigger is met in the given race situation
+func (s Skill) IsActive(situation RaceSituation) bool {
+	frontHalf := situation.Position <= (situation.FieldSize+1)/2
+
+	switch s.Trigger {
+	case TriggerStartDash:
+		return situation.Progress <= 0.15
+	case TriggerFinalStretch:
+		return situation.Progress >= 0.8
+	case TriggerCorner:
+		inCorner := situation.Progress <= 0.25 || situation.Progress >= 0.75
+		return inCorner && frontHalf
+	case TriggerRain:
+		return situation.Weather == Rainy
+	case TriggerLowStamina:
+		return situation.StaminaRatio < 0.3
+	case TriggerTrailing:
+		return situation.Progress >= 0.5 && !frontHalf
+	case TriggerLeading:
+		return situation.Position == 1
+	default:
+		return false
+	}
+}
+
+// HasSkill checks if the horse has learned a skill
+func (h *Horse) HasSkill(skillID string) bool {
+	for _, id := range h.Skills {
+		if id == skillID {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSkillEquipped checks if a learned skill is in one of the horse's skill slots
+func (h *Horse) IsSkillEquipped(skillID string) bool {
+	for _, id := range h.EquippedSkills {
+		if id == skillID {
+			return true
+		}
+	}
+	return false
+}
+
+// LearnSkill teaches the horse a skill, equipping it if a slot is free.
+// Returns false if the skill is unknown or already learned.
+func (h *Horse) LearnSkill(skillID string) bool {
+	if _, ok := GetSkill(skillID); !ok || h.HasSkill(skillID) {
+		return false
+	}
+
+	h.Skills = append(h.Skills, skillID)
+	if len(h.EquippedSkills) < MaxSkillSlots {
+		h.EquippedSkills = append(h.EquippedSkills, skillID)
+	}
+	return true
+}
+
+// AddSkillHint gives the horse a hint toward a skill and teaches the skill once enough hints
+// add up. Returns true if the hint completed the skill.
+func (h *Horse) AddSkillHint(skillID string) bool {
+	if _, ok := GetSkill(skillID); !ok || h.HasSkill(skillID) {
+		return false
+	}
+
+	if h.SkillHints == nil {
+		h.SkillHints = make(map[string]int)
+	}
+	h.SkillHints[skillID]++
+	if h.SkillHints[skillID] < SkillHintsToLearn {
+		return false
+	}
+
+	delete(h.SkillHints, skillID)
+	return h.LearnSkill(skillID)
+}
+
+// SkillHintProgress returns the hints the horse has collected toward a skill it hasn't learned
+func (h *Horse) SkillHintProgress(skillID string) int {
+	return h.SkillHints[skillID]
+}
+
+// SkillHintStatus describes the horse's progress toward a hinted skill
+func (h *Horse) SkillHintStatus(skillID string) string {
+	skill, ok := GetSkill(skillID)
+	if !ok {
+		return ""
+	}
+	if h.HasSkill(skillID) {
+		return fmt.Sprintf("%s %s learned", skill.Icon, skill.Name)
+	}
+	return fmt.Sprintf("%s %s hint %d/%d", skill.Icon, skill.Name, h.SkillHintProgress(skillID), SkillHintsToLearn)
+}
+
+// EquipSkill puts a learned skill into a free skill slot
+func (h *Horse) EquipSkill(skillID string) error {
+	if !h.HasSkill(skillID) {
+		return fmt.Errorf("skill not learned")
+	}
+	if h.IsSkillEquipped(skillID) {
+		return fmt.Errorf("skill already equipped")
+	}
+	if len(h.EquippedSkills) >= MaxSkillSlots {
+		return fmt.Errorf("all %d skill slots are full", MaxSkillSlots)
+	}
+
+	h.EquippedSkills = append(h.EquippedSkills, skillID)
+	return nil
+}
+
+// UnequipSkill frees the slot used by a skill
+func (h *Horse) UnequipSkill(skillID string) {
+	for i, id := range h.EquippedSkills {
+		if id == skillID {
+			h.EquippedSkills = append(h.EquippedSkills[:i], h.EquippedSkills[i+1:]...)
+			return
+		}
+	}
+}
+
+// GetEquippedSkills returns the skills the horse will use in races
+func (h *Horse) GetEquippedSkills() []Skill {
+	var skills []Skill
+	for _, id := range h.EquippedSkills {
+		if skill, ok := GetSkill(id); ok {
+			skills = append(skills, skill)
+		}
+	}
+	return skills
+}
+
+// CheckTrainingSkill teaches the skill for a training type once its stat reaches the milestone
+func (h *Horse) CheckTrainingSkill(trainingType TrainingType) (Skill, bool) {
+	skillID, ok := trainingSkills[trainingType.Stat()]
+	if !ok || h.HasSkill(skillID) {
+		return Skill{}, false
+	}
+
+	current := 0
+	switch trainingType.Stat() {
+	case StaminaTraining:
+		current = h.Stamina
+	case SpeedTraining:
+		current = h.Speed
+	case TechniqueTraining:
+		current = h.Technique
+	case MentalTraining:
+		current = h.Mental
+	}
+	if current < SkillTrainingMilestone || !h.LearnSkill(skillID) {
+		return Skill{}, false
+	}
+
+	skill, _ := GetSkill(skillID)
+	return skill, true
+}
+
+// CheckRaceSkills teaches skills earned by race results
+func (h *Horse) CheckRaceSkills(race Race, rank int, strategy RaceStrategy) []Skill {
+	var earned []string
+	if rank <= 3 && race.Weather == Rainy {
+		earned = append(earned, "rain_lover")
+	}
+	if rank == 1 && strategy.Formation == Lead {
+		earned = append(earned, "quick_start")
+	}
+	if h.Wins >= 5 {
+		earned = append(earned, "front_runner")
+	}
+
+	var learned []Skill
+	for _, skillID := range earned {
+		if h.LearnSkill(skillID) {
+			skill, _ := GetSkill(skillID)
+			learned = append(learned, skill)
+		}
+	}
+	return learned
+}
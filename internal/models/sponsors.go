@@ -0,0 +1,271 @@
+package models
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+const (
+	// MaxSponsorContracts is how many contracts a horse can hold at once
+	MaxSponsorContracts = 2
+	// sponsorHistoryLimit is how many finished contracts are kept for the sponsor screen
+	sponsorHistoryLimit = 20
+)
+
+var sponsorNames = []string{
+	"Golden Oats Feed Co.", "Swiftshoe Farriers", "Paddock Motors", "Silver Spur Insurance",
+	"Thunderhoof Energy", "Meadowlark Bank", "Crown Saddlery", "Starlight Jewellers",
+	"Bluegrass Mineral Water", "Hayloft Outfitters",
+}
+
+type ObjectiveKind int
+
+const (
+	PlaceObjective ObjectiveKind = iota // Finish in the top Position in Count races of Grade or better
+	FanObjective                        // Reach Fans fans
+)
+
+// SponsorObjective is what a sponsor wants from a horse before the season ends
+type SponsorObjective struct {
+	Kind     ObjectiveKind `json:"kind"`
+	Count    int           `json:"count,omitempty"`
+	Position int           `json:"position,omitempty"`
+	Grade    RaceGrade     `json:"grade,omitempty"`
+	Fans     int           `json:"fans,omitempty"`
+	From     int           `json:"from,omitempty"` // Fans when the contract was offered
+}
+
+func (o SponsorObjective) String() string {
+	if o.Kind == FanObjective {
+		return fmt.Sprintf("Reach %d fans", o.Fans)
+	}
+
+	races := "races"
+	if o.Count == 1 {
+		races = "race"
+	}
+	if o.Grade > MaidenRace {
+		races = fmt.Sprintf("%s or better %s", o.Grade.String(), races)
+	}
+	if o.Position == 1 {
+		return fmt.Sprintf("Win %d %s", o.Count, races)
+	}
+	return fmt.Sprintf("Place top %d in %d %s", o.Position, o.Count, races)
+}
+
+// Milestones returns how many paid steps the objective has
+func (o SponsorObjective) Milestones() int {
+	if o.Kind == FanObjective {
+		return 2 // Halfway and the target
+	}
+	return o.Count
+}
+
+type ContractStatus int
+
+const (
+	ContractActive ContractStatus = iota
+	ContractCompleted
+	ContractFailed
+)
+
+func (s ContractStatus) String() string {
+	switch s {
+	case ContractActive:
+		return "Active"
+	case ContractCompleted:
+		return "Completed"
+	case ContractFailed:
+		return "Failed"
+	default:
+		return "Unknown"
+	}
+}
+
+// SponsorContract is a sponsor's deal with one horse for the rest of a season
+type SponsorContract struct {
+	ID        string           `json:"id"`
+	Sponsor   string           `json:"sponsor"`
+	HorseID   string           `json:"horse_id"`
+	HorseName string           `json:"horse_name"`
+	Season    int              `json:"season"`
+	Objective SponsorObjective `json:"objective"`
+	Upfront   int              `json:"upfront"`   // Paid when the contract is signed
+	Milestone int              `json:"milestone"` // Paid for each milestone reached
+	Bonus     int              `json:"bonus"`     // Paid when the objective is met
+	Penalty   int              `json:"penalty"`   // Charged if the objective is unmet at season end
+	Progress  int              `json:"progress"`  // Milestones reached
+	Status    ContractStatus   `json:"status"`
+}
+
+// TotalPay returns everything the contract pays if the objective is met
+func (c SponsorContract) TotalPay() int {
+	return c.Upfront + c.Milestone*c.Objective.Milestones() + c.Bonus
+}
+
+// SponsorOffers returns this week's contract offers for the active horse. Better known
+// horses get more offers and bigger ones.
+func (gs *GameState) SponsorOffers() []SponsorContract {
+	horse := gs.PlayerHorse
+	if horse == nil || gs.Season.IsComplete() {
+		return nil
+	}
+
+	tier := FanTierFor(horse.FanSupport)
+	scale := tier + 1
+	rng := rand.New(rand.NewSource(int64(gs.Season.Number*100+gs.Season.CurrentWeek) + horse.CreatedAt.UnixNano()))
+
+	names := rng.Perm(len(sponsorNames))
+
+	var offers []SponsorContract
+	for i := 0; i < min(1+tier, 3); i++ {
+		var objective SponsorObjective
+		switch rng.Intn(3) {
+		case 0:
+			objective = SponsorObjective{Kind: PlaceObjective, Count: 2, Position: 3, Grade: RaceGrade(min(tier, int(Grade1)))}
+		case 1:
+			objective = SponsorObjective{Kind: PlaceObjective, Count: 1 + tier/2, Position: 1, Grade: RaceGrade(max(tier-1, 0))}
+		default:
+			target := horse.FanSupport * 3 / 2
+			if next, ok := horse.NextFanTier(); ok {
+				target = max(target, next.MinFans)
+			}
+			objective = SponsorObjective{Kind: FanObjective, From: horse.FanSupport, Fans: (target/500 + 1) * 500}
+		}
+
+		offer := SponsorContract{
+			ID:        fmt.Sprintf("%s-%d-%d-%d", horse.ID, gs.Season.Number, gs.Season.CurrentWeek, i),
+			Sponsor:   sponsorNames[names[i]],
+			HorseID:   horse.ID,
+			HorseName: horse.Name,
+			Season:    gs.Season.Number,
+			Objective: objective,
+			Upfront:   1000 * scale,
+			Milestone: 750 * scale,
+			Bonus:     2500 * scale,
+			Penalty:   2000 * scale,
+		}
+		if gs.GetContract(offer.ID) == nil {
+			offers = append(offers, offer)
+		}
+	}
+	return offers
+}
+
+// GetContract returns a signed contract by ID
+func (gs *GameState) GetContract(id string) *SponsorContract {
+	for i := range gs.Contracts {
+		if gs.Contracts[i].ID == id {
+			return &gs.Contracts[i]
+		}
+	}
+	return nil
+}
+
+// ActiveContracts returns the horse's contracts still in progress
+func (gs *GameState) ActiveContracts(horseID string) []SponsorContract {
+	var active []SponsorContract
+	for _, contract := range gs.Contracts {
+		if contract.HorseID == horseID && contract.Status == ContractActive {
+			active = append(active, contract)
+		}
+	}
+	return active
+}
+
+// SignContract accepts one of this week's offers and collects the upfront payment
+func (gs *GameState) SignContract(offerID string) error {
+	for _, offer := range gs.SponsorOffers() {
+		if offer.ID != offerID {
+			continue
+		}
+		if len(gs.ActiveContracts(offer.HorseID)) >= MaxSponsorContracts {
+			return fmt.Errorf("%s already has %d sponsors", offer.HorseName, MaxSponsorContracts)
+		}
+
+		gs.Contracts = append(gs.Contracts, offer)
+		gs.Earn(SponsorIncome, fmt.Sprintf("%s signing: %s", offer.Sponsor, offer.HorseName), offer.Upfront, offer.HorseID)
+		return nil
+	}
+	return fmt.Errorf("offer is no longer available")
+}
+
+// EvaluateContracts updates the horse's contracts after a race, or after AddFans changes
+// its fans when race is nil, paying milestones and bonuses as they are reached
+func (gs *GameState) EvaluateContracts(horse *Horse, race *Race, position int) []string {
+	var messages []string
+	for i := range gs.Contracts {
+		contract := &gs.Contracts[i]
+		if contract.HorseID != horse.ID || contract.Status != ContractActive {
+			continue
+		}
+
+		reached := contract.Progress
+		objective := contract.Objective
+		switch objective.Kind {
+		case PlaceObjective:
+			if race != nil && race.Grade >= objective.Grade && position <= objective.Position {
+				reached++
+			}
+		case FanObjective:
+			reached = min(max(horse.FanSupport-objective.From, 0)*2/max(objective.Fans-objective.From, 1), 2)
+		}
+
+		for contract.Progress < reached {
+			contract.Progress++
+			gs.Earn(SponsorIncome, fmt.Sprintf("%s milestone: %s", contract.Sponsor, horse.Name), contract.Milestone, horse.ID)
+			messages = append(messages, fmt.Sprintf("🤝 %s paid $%d for %s's milestone %d/%d",
+				contract.Sponsor, contract.Milestone, horse.Name, contract.Progress, objective.Milestones()))
+		}
+
+		if contract.Progress >= objective.Milestones() {
+			contract.Status = ContractCompleted
+			gs.Earn(SponsorIncome, fmt.Sprintf("%s bonus: %s", contract.Sponsor, horse.Name), contract.Bonus, horse.ID)
+			messages = append(messages, fmt.Sprintf("🏅 %s contract complete! %s paid a $%d bonus",
+				objective.String(), contract.Sponsor, contract.Bonus))
+		}
+	}
+	gs.pruneContracts()
+	return messages
+}
+
+// SettleContracts closes the season's contracts, charging the penalty on any objective left unmet
+func (gs *GameState) SettleContracts() []string {
+	var messages []string
+	for _, horse := range gs.Stable {
+		messages = append(messages, gs.EvaluateContracts(horse, nil, 0)...)
+	}
+
+	for i := range gs.Contracts {
+		contract := &gs.Contracts[i]
+		if contract.Status != ContractActive {
+			continue
+		}
+		contract.Status = ContractFailed
+		gs.Charge(SponsorPenalty, fmt.Sprintf("%s penalty: %s", contract.Sponsor, contract.HorseName), contract.Penalty, contract.HorseID)
+		messages = append(messages, fmt.Sprintf("📉 %s missed \"%s\" for %s: $%d penalty",
+			contract.HorseName, contract.Objective.String(), contract.Sponsor, contract.Penalty))
+	}
+	gs.pruneContracts()
+	return messages
+}
+
+// pruneContracts drops the oldest finished contracts past the history limit
+func (gs *GameState) pruneContracts() {
+	finished := 0
+	for _, contract := range gs.Contracts {
+		if contract.Status != ContractActive {
+			finished++
+		}
+	}
+
+	kept := gs.Contracts[:0]
+	for _, contract := range gs.Contracts {
+		if contract.Status != ContractActive && finished > sponsorHistoryLimit {
+			finished--
+			continue
+		}
+		kept = append(kept, contract)
+	}
+	gs.Contracts = kept
+}
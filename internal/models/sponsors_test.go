@@ -0,0 +1,65 @@
+package models
+
+import "testing"
+
+func TestContractMilestones(t *testing.T) {
+	place := SponsorObjective{Kind: PlaceObjective, Count: 2, Position: 3, Grade: Grade2}
+	fans := SponsorObjective{Kind: FanObjective, Fans: 3000, From: 1000}
+
+	tests := []struct {
+		name         string
+		objective    SponsorObjective
+		progress     int
+		horseID      string // Horse the contract is with; the test horse if empty
+		addFans      int    // Fans won through AddFans
+		grade        RaceGrade
+		position     int // Finishing position, or 0 for no race
+		wantProgress int
+		wantStatus   ContractStatus
+		wantPaid     int
+	}{
+		{"a qualifying finish pays a milestone", place, 0, "", 0, Grade2, 2, 1, ContractActive, 100},
+		{"the last milestone pays the bonus too", place, 1, "", 0, Grade1, 3, 2, ContractCompleted, 600},
+		{"a lower grade race doesn't count", place, 0, "", 0, Grade3, 1, 0, ContractActive, 0},
+		{"finishing too far back doesn't count", place, 0, "", 0, Grade2, 4, 0, ContractActive, 0},
+		{"fans halfway to the target pay a milestone", fans, 0, "", 1000, 0, 0, 1, ContractActive, 100},
+		{"reaching the fan target completes the contract", fans, 0, "", 2500, 0, 0, 2, ContractCompleted, 700},
+		{"a milestone already paid isn't paid again", fans, 1, "", 1200, 0, 0, 1, ContractActive, 0},
+		{"another horse's contract is left alone", place, 0, "other", 0, Grade2, 1, 0, ContractActive, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			horse := &Horse{ID: "horse", Name: "Test Horse", FanSupport: 1000}
+			horseID := tt.horseID
+			if horseID == "" {
+				horseID = horse.ID
+			}
+			gs := &GameState{Contracts: []SponsorContract{{
+				Sponsor:   "Test Sponsor",
+				HorseID:   horseID,
+				Objective: tt.objective,
+				Milestone: 100,
+				Bonus:     500,
+				Progress:  tt.progress,
+			}}}
+
+			if tt.addFans > 0 {
+				gs.AddFans(horse, tt.addFans)
+			}
+			if tt.position > 0 {
+				race := Race{Grade: tt.grade}
+				gs.EvaluateContracts(horse, &race, tt.position)
+			}
+
+			contract := gs.Contracts[0]
+			if contract.Progress != tt.wantProgress || contract.Status != tt.wantStatus {
+				t.Errorf("contract at %d milestones, %s; want %d, %s",
+					contract.Progress, contract.Status.String(), tt.wantProgress, tt.wantStatus.String())
+			}
+			if gs.Treasury != tt.wantPaid {
+				t.Errorf("paid $%d, want $%d", gs.Treasury, tt.wantPaid)
+			}
+		})
+	}
+}
@@ -0,0 +1,245 @@
+package models
+
+import "fmt"
+
+// MaxBarnLevel is the highest barn upgrade available
+const MaxBarnLevel = 4
+
+// StableCapacity returns how many active horses the barn can hold
+func (gs *GameState) StableCapacity() int {
+	return 1 + max(gs.BarnLevel, 1)
+}
+
+// BarnUpgradeCost returns the price of the next barn upgrade
+func (gs *GameState) BarnUpgradeCost() int {
+	return 25000 * max(gs.BarnLevel, 1)
+}
+
+// UpgradeBarn adds a stall to the barn, paid from the stable treasury
+func (gs *GameState) UpgradeBarn() error {
+	if gs.BarnLevel >= MaxBarnLevel {
+		return fmt.Errorf("barn already fully upgraded")
+	}
+
+	nextLevel := max(gs.BarnLevel, 1) + 1
+	if err := gs.Spend(PurchaseExpense, fmt.Sprintf("Barn upgrade to level %d", nextLevel), gs.BarnUpgradeCost(), ""); err != nil {
+		return err
+	}
+	gs.BarnLevel = nextLevel
+	return nil
+}
+
+// CanAddHorse checks if there is a free stall in the barn
+func (gs *GameState) CanAddHorse() bool {
+	return len(gs.Stable) < gs.StableCapacity()
+}
+
+// AddHorseToStable adds a newly scouted horse to the stable and makes it the active horse
+func (gs *GameState) AddHorseToStable(horse *Horse) error {
+	if !gs.CanAddHorse() {
+		return fmt.Errorf("stable is full")
+	}
+	if gs.GetStableHorse(horse.ID) != nil {
+		return fmt.Errorf("%s is already in the stable", horse.Name)
+	}
+	if gs.isRetired(horse.ID) {
+		return fmt.Errorf("%s has already retired", horse.Name)
+	}
+
+	gs.Stable = append(gs.Stable, horse)
+	return gs.SetActiveHorse(horse.ID)
+}
+
+// RemoveAvailableHorse takes a scouted horse out of the scouting pool once it has been chosen
+func (gs *GameState) RemoveAvailableHorse(horseID string) {
+	for i, horse := range gs.AvailableHorses {
+		if horse.ID == horseID {
+			gs.AvailableHorses = append(gs.AvailableHorses[:i], gs.AvailableHorses[i+1:]...)
+			return
+		}
+	}
+}
+
+// isRetired reports whether a horse with the ID has been retired
+func (gs *GameState) isRetired(horseID string) bool {
+	for _, retired := range gs.RetiredHorses {
+		if retired.Horse.ID == horseID {
+			return true
+		}
+	}
+	return false
+}
+
+// GetStableHorse returns the stable horse with the given ID
+func (gs *GameState) GetStableHorse(horseID string) *Horse {
+	for _, horse := range gs.Stable {
+		if horse.ID == horseID {
+			return horse
+		}
+	}
+	return nil
+}
+
+// SetActiveHorse switches the horse being managed, swapping in its supporter deck
+func (gs *GameState) SetActiveHorse(horseID string) error {
+	horse := gs.GetStableHorse(horseID)
+	if horse == nil {
+		return fmt.Errorf("horse not found in stable")
+	}
+
+	if gs.HorseSupporters == nil {
+		gs.HorseSupporters = make(map[string][]string)
+	}
+	if gs.ActiveHorseID != "" {
+		gs.HorseSupporters[gs.ActiveHorseID] = gs.ActiveSupporters
+	}
+
+	gs.ActiveSupporters = gs.HorseSupporters[horseID]
+	if gs.ActiveSupporters == nil {
+		gs.ActiveSupporters = make([]string, 0)
+	}
+
+	gs.ActiveHorseID = horseID
+	gs.PlayerHorse = horse
+	return nil
+}
+
+// RemoveFromStable takes a horse out of the stable and activates the next one, if any
+func (gs *GameState) RemoveFromStable(horseID string) {
+	for i, horse := range gs.Stable {
+		if horse.ID == horseID {
+			gs.Stable = append(gs.Stable[:i], gs.Stable[i+1:]...)
+			break
+		}
+	}
+	delete(gs.HorseSupporters, horseID)
+	gs.ReleaseJockey(horseID)
+
+	if gs.ActiveHorseID != horseID {
+		return
+	}
+
+	gs.ActiveHorseID = ""
+	gs.PlayerHorse = nil
+	gs.ActiveSupporters = make([]string, 0)
+	if len(gs.Stable) > 0 {
+		gs.SetActiveHorse(gs.Stable[0].ID)
+	}
+}
+
+// RelinkStable restores the active horse pointer after loading a save and
+// migrates saves from before the stable existed
+func (gs *GameState) RelinkStable() {
+	if gs.BarnLevel < 1 {
+		gs.BarnLevel = 1
+	}
+	if gs.HorseSupporters == nil {
+		gs.HorseSupporters = make(map[string][]string)
+	}
+
+	if len(gs.Stable) == 0 && gs.PlayerHorse != nil {
+		gs.Stable = []*Horse{gs.PlayerHorse}
+		gs.ActiveHorseID = gs.PlayerHorse.ID
+	}
+
+	// Training days recorded before the stable belong to the original horse
+	for i := range gs.Season.TrainingDays {
+		if gs.Season.TrainingDays[i].HorseID == "" {
+			gs.Season.TrainingDays[i].HorseID = gs.ActiveHorseID
+		}
+	}
+
+	// Race results were once keyed by race alone, with the race listed again for every run
+	results := make(map[string]CompletedRaceResult, len(gs.Season.RaceResults))
+	for _, result := range gs.Season.RaceResults {
+		if result.HorseID == "" {
+			result.HorseID = gs.ActiveHorseID
+		}
+		results[RaceResultKey(result.RaceID, result.HorseID)] = result
+	}
+	gs.Season.RaceResults = results
+
+	listed := make(map[string]bool, len(gs.Season.CompletedRaces))
+	races := make([]string, 0, len(gs.Season.CompletedRaces))
+	for _, raceID := range gs.Season.CompletedRaces {
+		if !listed[raceID] {
+			listed[raceID] = true
+			races = append(races, raceID)
+		}
+	}
+	gs.Season.CompletedRaces = races
+
+	// Scouted horses were once left in the scouting pool after joining the stable
+	available := gs.AvailableHorses[:0]
+	for _, horse := range gs.AvailableHorses {
+		if gs.GetStableHorse(horse.ID) == nil && !gs.isRetired(horse.ID) {
+			available = append(available, horse)
+		}
+	}
+	gs.AvailableHorses = available
+
+	gs.PlayerHorse = gs.GetStableHorse(gs.ActiveHorseID)
+	if gs.PlayerHorse == nil && len(gs.Stable) > 0 {
+		gs.ActiveHorseID = gs.Stable[0].ID
+		gs.PlayerHorse = gs.Stable[0]
+	}
+}
+
+// IsWeekCompleteFor checks if a horse has used all six training days this week
+func (gs *GameState) IsWeekCompleteFor(horseID string) bool {
+	completed := 0
+	for _, day := range gs.Season.GetHorseTrainingDays(horseID) {
+		if day.IsCompleted {
+			completed++
+		}
+	}
+	return completed >= 6
+}
+
+// CompleteWeek runs each stable horse's scheduled training plan, rests it on any days
+// still unscheduled, pays out the week's passive income and home upkeep, advances the
+// season week and may raise a season event. A plan event that needs a decision leaves the
+// week open, and CompleteWeek reports whether the week was closed.
+func (gs *GameState) CompleteWeek() bool {
+	if gs.PendingEvent != nil {
+		return false
+	}
+	for _, horse := range gs.Stable {
+		if gs.followScheduledPlan(horse) {
+			return false
+		}
+	}
+
+	for _, horse := range gs.Stable {
+		scheduled := make(map[int]bool)
+		for _, day := range gs.Season.GetHorseTrainingDays(horse.ID) {
+			if day.IsCompleted {
+				scheduled[day.Day] = true
+			}
+		}
+
+		for day := 0; day < 6; day++ {
+			if scheduled[day] {
+				continue
+			}
+			horse.Rest()
+			gs.Season.AddTrainingDay(TrainingDay{
+				HorseID:     horse.ID,
+				Week:        gs.Season.CurrentWeek,
+				Day:         day,
+				IsRest:      true,
+				IsCompleted: true,
+			})
+		}
+	}
+
+	// The final week of a season is paid out when the season ends
+	if !gs.Season.IsComplete() {
+		gs.AccruePassiveGains()
+		gs.PayFanSponsorships()
+		gs.ChargeHomeUpkeep()
+		gs.Season.NextWeek()
+		gs.rollSeasonEvent()
+	}
+	return true
+}
@@ -1,5 +1,11 @@
 package models
 
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+)
+
 type Supporter struct {
 	ID            string               `json:"id"`
 	Name          string               `json:"name"`
@@ -8,6 +14,9 @@ type Supporter struct {
 	TrainingBonus map[TrainingType]int `json:"training_bonus"`
 	SpecialEffect string               `json:"special_effect"`
 	IsOwned       bool                 `json:"is_owned"`
+	Bond          int                  `json:"bond,omitempty"`        // 0-100, grows when training their specialty
+	Level         int                  `json:"level,omitempty"`       // Card level, raised with training manuals
+	LimitBreak    int                  `json:"limit_break,omitempty"` // Duplicates merged into the card, raising its level cap
 }
 
 type Rarity int
@@ -59,3 +68,55 @@ func NewSupporter(name, description string, rarity Rarity, bonuses map[TrainingT
 		IsOwned:       false,
 	}
 }
+
+// CommentaryMoments are the points in a race where supporters call out from the stands
+var CommentaryMoments = []string{"start", "stretch", "win"}
+
+// SupporterProfile is a supporter card's flavour from the content files
+type SupporterProfile struct {
+	ID         string              `json:"id"`
+	Commentary map[string][]string `json:"commentary"` // Race moment -> lines; {horse} is replaced with the horse's name
+}
+
+// ProfileLibrary maps supporter IDs to their profiles
+type ProfileLibrary map[string]SupporterProfile
+
+// Validate checks the profile only uses race moments the game understands
+func (p SupporterProfile) Validate() error {
+	if p.ID == "" {
+		return fmt.Errorf("supporter profile is missing an id")
+	}
+	for moment, lines := range p.Commentary {
+		known := false
+		for _, name := range CommentaryMoments {
+			if moment == name {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return fmt.Errorf("supporter profile %s: unknown race moment %q", p.ID, moment)
+		}
+		if len(lines) == 0 {
+			return fmt.Errorf("supporter profile %s: no lines for %s", p.ID, moment)
+		}
+	}
+	return nil
+}
+
+// SupporterCheers picks a line for each race moment from each active supporter with commentary,
+// ready for the race simulator
+func (gs *GameState) SupporterCheers(horse *Horse) map[string][]string {
+	cheers := make(map[string][]string)
+	for _, supporter := range gs.GetActiveSupporters() {
+		profile, ok := gs.SupporterProfiles[supporter.ID]
+		if !ok {
+			continue
+		}
+		for moment, lines := range profile.Commentary {
+			line := strings.ReplaceAll(lines[rand.Intn(len(lines))], "{horse}", horse.Name)
+			cheers[moment] = append(cheers[moment], fmt.Sprintf("📣 %s: \"%s\"", supporter.Name, line))
+		}
+	}
+	return cheers
+}
@@ -0,0 +1,108 @@
+package models
+
+// Weighting decides how much weight each entrant in a race carries
+type Weighting int
+
+const (
+	LevelWeights Weighting = iota // Every horse carries the standard weight
+	Handicap                      // Weight from rating and recent form, to even out the field
+	Allowance                     // Standard weight less weight-for-age and sex allowances
+)
+
+func (w Weighting) String() string {
+	switch w {
+	case LevelWeights:
+		return "Level Weights"
+	case Handicap:
+		return "Handicap"
+	case Allowance:
+		return "Allowance"
+	default:
+		return "Unknown"
+	}
+}
+
+// WeightMap maps horse IDs to the weight in kg each carries
+type WeightMap map[string]int
+
+const (
+	// StandardWeight is the weight in kg carried in level-weights races
+	StandardWeight = 57
+	// MinWeight and MaxWeight bound the weight a handicapper can assign
+	MinWeight = 50
+	MaxWeight = 64
+	// WeightSpeedPenalty is the fraction of speed lost per kg above the standard weight
+	WeightSpeedPenalty = 0.01
+	// maxRecentForm is how many finishing positions a horse remembers for handicapping
+	maxRecentForm = 5
+)
+
+// RecordForm remembers a finishing position for handicapping future races
+func (h *Horse) RecordForm(position int) {
+	h.RecentForm = append(h.RecentForm, position)
+	if len(h.RecentForm) > maxRecentForm {
+		h.RecentForm = h.RecentForm[len(h.RecentForm)-maxRecentForm:]
+	}
+}
+
+// formWeight is the handicapper's adjustment for recent form: +1 kg for each win in
+// the last 3 races, -1 kg for a horse on a losing streak
+func (h *Horse) formWeight() int {
+	weight := 0
+	recent := h.RecentForm[max(len(h.RecentForm)-3, 0):]
+	for _, position := range recent {
+		if position == 1 {
+			weight++
+		}
+	}
+	if h.LosingStreak > LosingStreakGrace {
+		weight--
+	}
+	return weight
+}
+
+// WeightFor returns the weight in kg the horse would carry in this race
+func (r *Race) WeightFor(horse *Horse) int {
+	switch r.Weighting {
+	case Handicap:
+		// Weighted against a horse of the race's typical rating
+		par := r.MinRating + r.MinRating/4
+		weight := StandardWeight + (horse.GetOverallRating()-par)/5 + horse.formWeight()
+		return min(max(weight, MinWeight), MaxWeight)
+	case Allowance:
+		weight := StandardWeight
+		switch {
+		case horse.Age <= 2:
+			weight -= 4
+		case horse.Age == 3:
+			weight -= 2
+		}
+		if horse.Sex == Mare {
+			weight -= 2
+		}
+		return weight
+	default:
+		return StandardWeight
+	}
+}
+
+// AssignWeights sets the weight carried by every horse in the field
+func (r *Race) AssignWeights(horses map[string]*Horse) {
+	r.Weights = make(WeightMap, len(horses))
+	for id, horse := range horses {
+		r.Weights[id] = r.WeightFor(horse)
+	}
+}
+
+// WeightCarried returns the weight assigned to an entrant, or the standard weight if none was set
+func (r *Race) WeightCarried(horseID string) int {
+	if weight, ok := r.Weights[horseID]; ok {
+		return weight
+	}
+	return StandardWeight
+}
+
+// WeightFactor returns the speed multiplier for carrying the given weight
+func WeightFactor(weight int) float64 {
+	return 1.0 - float64(weight-StandardWeight)*WeightSpeedPenalty
+}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"math"
+	"testing"
+)
+
+func TestWeightFactor(t *testing.T) {
+	tests := []struct {
+		name   string
+		weight int
+		want   float64
+	}{
+		{"standard weight runs at full speed", StandardWeight, 1.0},
+		{"each kg over the standard costs a point of speed", StandardWeight + 3, 0.97},
+		{"the top weight", MaxWeight, 0.93},
+		{"each kg under the standard gains a point of speed", StandardWeight - 2, 1.02},
+		{"the bottom weight", MinWeight, 1.07},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WeightFactor(tt.weight); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("WeightFactor(%d) = %v, want %v", tt.weight, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,322 @@
+// Package planner searches training and rest schedules that prepare a horse for a target race.
+package planner
+
+import (
+	"fmt"
+	"sort"
+
+	"goderby/internal/game"
+	"goderby/internal/models"
+)
+
+// DefaultBeamWidth is how many candidate schedules are kept at each day of the search
+const DefaultBeamWidth = 40
+
+type Goal int
+
+const (
+	WinChance Goal = iota // Maximise the estimated chance of winning the target race
+	Rating                // Maximise overall rating on race day
+)
+
+func (g Goal) String() string {
+	switch g {
+	case WinChance:
+		return "Win chance"
+	case Rating:
+		return "Rating"
+	default:
+		return "Unknown"
+	}
+}
+
+// Slot is a training day available before the race
+type Slot struct {
+	Week int
+	Day  int
+}
+
+// Step is what the horse does on a slot
+type Step struct {
+	Slot
+	Rest         bool
+	TrainingType models.TrainingType
+}
+
+func (s Step) String() string {
+	if s.Rest {
+		return "Rest"
+	}
+	return s.TrainingType.String()
+}
+
+// Snapshot is the horse's projected condition after a step
+type Snapshot struct {
+	Stamina   int
+	Speed     int
+	Technique int
+	Mental    int
+	Fatigue   int
+	Morale    int
+	Rating    int
+}
+
+// Plan is a proposed schedule with its projected outcome
+type Plan struct {
+	Steps      []Step
+	Projection []Snapshot // Starting condition followed by the condition after each step
+	WinChance  float64    // Estimated chance of winning the race on race day
+	Rating     int        // Overall rating on race day
+}
+
+// Final returns the projected condition on race day
+func (p Plan) Final() Snapshot {
+	return p.Projection[len(p.Projection)-1]
+}
+
+// Planner searches schedules for one horse and target race using the real training rules
+type Planner struct {
+	horse      *models.Horse
+	supporters []models.Supporter
+	race       models.Race
+	rivals     []*models.Horse
+	goal       Goal
+	types      []models.TrainingType
+	facilities map[models.TrainingType]models.FacilityBonus
+	BeamWidth  int
+}
+
+func New(gs *models.GameState, horse *models.Horse, supporters []models.Supporter, race models.Race, goal Goal) *Planner {
+	var rivals []*models.Horse
+	for slot := 1; slot < race.FieldSize(); slot++ {
+		rating := race.RivalRating(slot)
+		rivals = append(rivals, &models.Horse{
+			ID:        fmt.Sprintf("rival_%d", slot),
+			Age:       3,
+			Stamina:   rating,
+			Speed:     rating,
+			Technique: rating,
+			Mental:    rating,
+			Morale:    100,
+		})
+	}
+
+	types := gs.AvailableTrainingTypes()
+	facilities := make(map[models.TrainingType]models.FacilityBonus)
+	for _, trainingType := range types {
+		facilities[trainingType] = gs.FacilityBonus(trainingType)
+	}
+
+	return &Planner{
+		horse:      horse,
+		supporters: supporters,
+		race:       race,
+		rivals:     rivals,
+		goal:       goal,
+		types:      types,
+		facilities: facilities,
+		BeamWidth:  DefaultBeamWidth,
+	}
+}
+
+// Slots lists a horse's unused training days from now through the end of a week
+func Slots(gs *models.GameState, horseID string, throughWeek int) []Slot {
+	used := make(map[int]bool)
+	for _, day := range gs.Season.GetHorseTrainingDays(horseID) {
+		if day.IsCompleted {
+			used[day.Day] = true
+		}
+	}
+
+	var slots []Slot
+	for week := gs.Season.CurrentWeek; week <= throughWeek; week++ {
+		for day := 0; day < models.DaysPerWeek; day++ {
+			if week == gs.Season.CurrentWeek && used[day] {
+				continue
+			}
+			slots = append(slots, Slot{Week: week, Day: day})
+		}
+	}
+	return slots
+}
+
+// node is a partial schedule in the beam search
+type node struct {
+	horse  models.Horse
+	step   Step
+	parent *node
+	score  float64
+}
+
+// stateKey identifies equivalent horse states so the beam keeps distinct schedules
+type stateKey struct {
+	stamina, speed, technique, mental int
+	fatigue, morale                   int
+	last                              models.TrainingType
+	streak                            int
+}
+
+// Optimize runs a beam search over training and rest for each slot and returns the best plan
+func (p *Planner) Optimize(slots []Slot) Plan {
+	beam := []*node{{horse: cloneHorse(p.horse)}}
+	actions := []Step{{Rest: true}}
+	for _, trainingType := range p.types {
+		actions = append(actions, Step{TrainingType: trainingType})
+	}
+
+	for i, slot := range slots {
+		progress := float64(i+1) / float64(len(slots))
+		seen := make(map[stateKey]bool)
+		var next []*node
+
+		for _, parent := range beam {
+			for _, action := range actions {
+				horse := cloneHorse(&parent.horse)
+				step := action
+				step.Slot = slot
+				if !p.apply(&horse, step) {
+					continue
+				}
+
+				key := keyFor(&horse)
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+
+				next = append(next, &node{
+					horse:  horse,
+					step:   step,
+					parent: parent,
+					score:  p.evaluate(&horse, progress),
+				})
+			}
+		}
+
+		sort.SliceStable(next, func(a, b int) bool { return next[a].score > next[b].score })
+		if len(next) > p.BeamWidth {
+			next = next[:p.BeamWidth]
+		}
+		beam = next
+	}
+
+	var steps []Step
+	for n := beam[0]; n.parent != nil; n = n.parent {
+		steps = append([]Step{n.step}, steps...)
+	}
+	return p.Project(steps)
+}
+
+// Project plays out a schedule and reports the projected condition after each step.
+// Training days the horse is too tired for are turned into rest, as the game would.
+// Injuries and training events are left out of the projection: apply uses horse.Train,
+// which never rolls them, so the real week can fall short of the plan.
+func (p *Planner) Project(steps []Step) Plan {
+	horse := cloneHorse(p.horse)
+	plan := Plan{Projection: []Snapshot{snapshot(&horse)}}
+
+	for _, step := range steps {
+		if !p.apply(&horse, step) {
+			step.Rest = true
+			p.apply(&horse, step)
+		}
+		plan.Steps = append(plan.Steps, step)
+		plan.Projection = append(plan.Projection, snapshot(&horse))
+	}
+
+	plan.WinChance = game.EstimateWinChance(p.race, &horse, p.rivals)
+	plan.Rating = horse.GetOverallRating()
+	return plan
+}
+
+// Schedule turns a plan into weekly training plans for the horse. Days outside the plan,
+// such as ones already used this week, are left as rest.
+func Schedule(gs *models.GameState, horseID, name string, plan Plan) error {
+	weeks := make(map[int][]models.PlanDay)
+	var order []int
+	for _, step := range plan.Steps {
+		days, ok := weeks[step.Week]
+		if !ok {
+			days = make([]models.PlanDay, models.DaysPerWeek)
+			for i := range days {
+				days[i].Rest = true
+			}
+			order = append(order, step.Week)
+		}
+		days[step.Day] = models.PlanDay{Rest: step.Rest, TrainingType: step.TrainingType}
+		weeks[step.Week] = days
+	}
+
+	if len(order) == 0 {
+		return fmt.Errorf("no training days to schedule")
+	}
+	for _, week := range order {
+		weekPlan := models.TrainingPlan{Name: name, Days: weeks[week]}
+		if err := gs.SchedulePlan(horseID, weekPlan, week, week); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// evaluate scores a horse state for the goal. Part way through the schedule fatigue only
+// counts in proportion to progress, since there is still time to rest it off.
+func (p *Planner) evaluate(horse *models.Horse, progress float64) float64 {
+	scored := *horse
+	scored.Fatigue = int(float64(horse.Fatigue) * progress)
+
+	switch p.goal {
+	case Rating:
+		base := float64(scored.Stamina+scored.Speed+scored.Technique+scored.Mental) / 4
+		return base*scored.GetAgePerformanceFactor() - float64(scored.Fatigue)/10
+	default:
+		// The margin over the strongest rival, which unlike the win chance doesn't
+		// flatten out when the horse is far behind or ahead of the field
+		return game.WinMargin(p.race, &scored, p.rivals)
+	}
+}
+
+// apply runs one step on the horse, returning false if the horse couldn't train
+func (p *Planner) apply(horse *models.Horse, step Step) bool {
+	if step.Rest {
+		horse.Rest()
+		return true
+	}
+	return horse.Train(step.TrainingType, p.supporters, p.facilities[step.TrainingType]).Success
+}
+
+func cloneHorse(horse *models.Horse) models.Horse {
+	clone := *horse
+	clone.RecentTraining = append([]models.TrainingType(nil), horse.RecentTraining...)
+	return clone
+}
+
+func keyFor(horse *models.Horse) stateKey {
+	key := stateKey{
+		stamina:   horse.Stamina,
+		speed:     horse.Speed,
+		technique: horse.Technique,
+		mental:    horse.Mental,
+		fatigue:   horse.Fatigue,
+		morale:    horse.Morale,
+	}
+	if n := len(horse.RecentTraining); n > 0 {
+		key.last = horse.RecentTraining[n-1]
+		for i := n - 1; i >= 0 && horse.RecentTraining[i] == key.last; i-- {
+			key.streak++
+		}
+	}
+	return key
+}
+
+func snapshot(horse *models.Horse) Snapshot {
+	return Snapshot{
+		Stamina:   horse.Stamina,
+		Speed:     horse.Speed,
+		Technique: horse.Technique,
+		Mental:    horse.Mental,
+		Fatigue:   horse.Fatigue,
+		Morale:    horse.Morale,
+		Rating:    horse.GetOverallRating(),
+	}
+}
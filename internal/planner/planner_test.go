@@ -0,0 +1,74 @@
+package planner
+
+import (
+	"testing"
+
+	"goderby/internal/models"
+)
+
+func TestSlots(t *testing.T) {
+	tests := []struct {
+		name        string
+		used        []int // Days already completed this week
+		throughWeek int
+		want        int
+	}{
+		{"the rest of an unused week", nil, 1, models.DaysPerWeek},
+		{"used days this week are skipped", []int{0, 1, 4}, 1, models.DaysPerWeek - 3},
+		{"later weeks are open in full", []int{0, 1}, 3, models.DaysPerWeek*3 - 2},
+		{"a race this week with every day used", []int{0, 1, 2, 3, 4, 5}, 1, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gs := models.NewGameState()
+			for _, day := range tt.used {
+				gs.Season.AddTrainingDay(models.TrainingDay{HorseID: "horse", Week: gs.Season.CurrentWeek, Day: day, IsRest: true, IsCompleted: true})
+			}
+			slots := Slots(gs, "horse", tt.throughWeek)
+			if len(slots) != tt.want {
+				t.Fatalf("got %d slots, want %d", len(slots), tt.want)
+			}
+			for _, slot := range slots {
+				if slot.Week == gs.Season.CurrentWeek {
+					for _, day := range tt.used {
+						if slot.Day == day {
+							t.Errorf("slot on used day %d", day)
+						}
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestEvaluateRanksHorsesFarFromTheField(t *testing.T) {
+	// A field rated well above the horses being scored, where the win chance is all but zero
+	race := *models.NewRace("Test Cup", 2000, models.GradeG1, 100000, 400)
+	p := New(models.NewGameState(), &models.Horse{}, nil, race, WinChance)
+
+	tests := []struct {
+		name         string
+		weaker       int // Every stat of the weaker horse
+		stronger     int // Every stat of the stronger horse
+		wantStronger bool
+	}{
+		{"far behind the field", 60, 80, true},
+		{"closer to the field", 200, 300, true},
+		{"equal horses score the same", 150, 150, false},
+	}
+
+	horse := func(stat int) *models.Horse {
+		return &models.Horse{Age: 4, Stamina: stat, Speed: stat, Technique: stat, Mental: stat, Morale: 100}
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			weaker := p.evaluate(horse(tt.weaker), 1)
+			stronger := p.evaluate(horse(tt.stronger), 1)
+			if got := stronger > weaker; got != tt.wantStronger {
+				t.Errorf("scores %v (stats %d) and %v (stats %d): stronger ranked higher = %v, want %v",
+					weaker, tt.weaker, stronger, tt.stronger, got, tt.wantStronger)
+			}
+		})
+	}
+}
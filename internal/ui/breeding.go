@@ -0,0 +1,358 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"goderby/internal/models"
+)
+
+type BreedingModel struct {
+	gameState  *models.GameState
+	mode       BreedingMode
+	dams       []models.RetiredHorse
+	sires      []sireCandidate
+	damCursor  int
+	sireCursor int
+	foal       *models.Horse
+	message    string
+	damStart   int // First mare shown, for scrolling
+	sireStart  int // First stallion shown, for scrolling
+	maxVisible int // Maximum candidates visible at once
+}
+
+type BreedingMode int
+
+const (
+	SelectingDam BreedingMode = iota
+	SelectingSire
+	ConfirmingBreeding
+	ViewingFoal
+)
+
+// sireCandidate is either a stud from the player's own retired horses or an outside stud
+type sireCandidate struct {
+	Horse   models.Horse
+	Fee     int
+	Outside bool
+}
+
+func NewBreedingModel(gameState *models.GameState) BreedingModel {
+	var sires []sireCandidate
+	for _, stud := range gameState.GetStudHorses() {
+		sires = append(sires, sireCandidate{Horse: stud.Horse})
+	}
+	for _, stud := range models.DefaultOutsideStuds() {
+		sires = append(sires, sireCandidate{Horse: stud.Horse, Fee: stud.Fee, Outside: true})
+	}
+
+	return BreedingModel{
+		gameState:  gameState,
+		mode:       SelectingDam,
+		dams:       gameState.GetBreedingMares(),
+		sires:      sires,
+		maxVisible: 3,
+	}
+}
+
+func (m BreedingModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m BreedingModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q":
+			return m, func() tea.Msg {
+				return NavigationMsg{State: MainMenuView}
+			}
+		case "esc":
+			switch m.mode {
+			case SelectingSire:
+				m.mode = SelectingDam
+				m.message = ""
+			case ConfirmingBreeding:
+				m.mode = SelectingSire
+				m.message = ""
+			default:
+				return m, func() tea.Msg {
+					return NavigationMsg{State: MainMenuView}
+				}
+			}
+		case "up", "k":
+			cursor, start := m.currentCursor(), m.currentViewStart()
+			if *cursor > 0 {
+				*cursor--
+				if *cursor < *start {
+					*start = *cursor
+				}
+			}
+		case "down", "j":
+			cursor, start := m.currentCursor(), m.currentViewStart()
+			if *cursor < m.currentListLength()-1 {
+				*cursor++
+				if *cursor >= *start+m.maxVisible {
+					*start = *cursor - m.maxVisible + 1
+				}
+			}
+		case "enter", " ":
+			switch m.mode {
+			case SelectingDam:
+				if len(m.dams) == 0 {
+					return m, nil
+				}
+				if !m.gameState.CanBreedMare(m.dams[m.damCursor].Horse.ID) {
+					m.message = fmt.Sprintf("%s has already been bred this season", m.dams[m.damCursor].Horse.Name)
+					return m, nil
+				}
+				m.mode = SelectingSire
+				m.message = ""
+			case SelectingSire:
+				if len(m.sires) > 0 {
+					m.mode = ConfirmingBreeding
+				}
+			case ConfirmingBreeding:
+				foal, err := m.gameState.Breed(m.sires[m.sireCursor].Horse.ID, m.dams[m.damCursor].Horse.ID)
+				if err != nil {
+					m.message = err.Error()
+					return m, nil
+				}
+				m.foal = foal
+				m.mode = ViewingFoal
+			case ViewingFoal:
+				return m, func() tea.Msg {
+					return NavigationMsg{State: MainMenuView}
+				}
+			}
+		}
+	}
+
+	return m, nil
+}
+
+func (m *BreedingModel) currentCursor() *int {
+	if m.mode == SelectingSire {
+		return &m.sireCursor
+	}
+	return &m.damCursor
+}
+
+// currentViewStart returns the scroll offset of the list being chosen from; each list
+// keeps its own so the remembered cursor stays on screen when switching back
+func (m *BreedingModel) currentViewStart() *int {
+	if m.mode == SelectingSire {
+		return &m.sireStart
+	}
+	return &m.damStart
+}
+
+func (m BreedingModel) currentListLength() int {
+	switch m.mode {
+	case SelectingDam:
+		return len(m.dams)
+	case SelectingSire:
+		return len(m.sires)
+	default:
+		return 0
+	}
+}
+
+func (m BreedingModel) View() string {
+	switch m.mode {
+	case ViewingFoal:
+		return m.renderFoalView()
+	case ConfirmingBreeding:
+		return m.renderConfirmView()
+	case SelectingSire:
+		return m.renderSireView()
+	default:
+		return m.renderDamView()
+	}
+}
+
+func (m BreedingModel) renderDamView() string {
+	var b strings.Builder
+
+	b.WriteString(RenderTitle("🐣 Breeding Program"))
+	b.WriteString("\n\n")
+
+	if len(m.dams) == 0 {
+		b.WriteString(RenderWarning("No mares in your breeding program yet!"))
+		b.WriteString("\n")
+		b.WriteString(RenderInfo("Retire a mare with the Breeding Mare role to start a bloodline."))
+		b.WriteString("\n\n")
+		b.WriteString(RenderHelp("ESC/q to go back"))
+		return lipgloss.NewStyle().Padding(1, 2).Render(b.String())
+	}
+
+	b.WriteString(RenderHeader("Select a Mare"))
+	b.WriteString("\n")
+	b.WriteString(RenderInfo("Each mare can be bred once per season"))
+	b.WriteString("\n\n")
+
+	viewEnd := min(m.damStart+m.maxVisible, len(m.dams))
+	for i := m.damStart; i < viewEnd; i++ {
+		dam := m.dams[i]
+		cursor := " "
+		if m.damCursor == i {
+			cursor = ">"
+		}
+
+		info := fmt.Sprintf("%s %s", cursor, renderBreedingCandidate(dam.Horse))
+		info += fmt.Sprintf("\n   Home: %s | Foals: %d", dam.RetirementHome.Name, dam.FoalsProduced)
+		if !m.gameState.CanBreedMare(dam.Horse.ID) {
+			info += " | Bred this season ✓"
+		}
+
+		b.WriteString(RenderCard(info, m.damCursor == i))
+		b.WriteString("\n")
+	}
+
+	if len(m.dams) > m.maxVisible {
+		b.WriteString(RenderInfo(scrollInfo(m.damStart, viewEnd, len(m.dams), "mares")))
+		b.WriteString("\n")
+	}
+
+	if m.message != "" {
+		b.WriteString("\n")
+		b.WriteString(RenderWarning(m.message))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(RenderHelp("Enter to select mare, ↑/↓ to navigate, ESC/q to go back"))
+
+	return lipgloss.NewStyle().Padding(1, 2).Render(b.String())
+}
+
+func (m BreedingModel) renderSireView() string {
+	var b strings.Builder
+
+	b.WriteString(RenderTitle("🐣 Choose a Stallion"))
+	b.WriteString("\n\n")
+
+	b.WriteString(RenderHeader(fmt.Sprintf("Pairing for %s", m.dams[m.damCursor].Horse.Name)))
+	b.WriteString("\n\n")
+
+	viewEnd := min(m.sireStart+m.maxVisible, len(m.sires))
+	for i := m.sireStart; i < viewEnd; i++ {
+		sire := m.sires[i]
+		cursor := " "
+		if m.sireCursor == i {
+			cursor = ">"
+		}
+
+		info := fmt.Sprintf("%s %s", cursor, renderBreedingCandidate(sire.Horse))
+		if sire.Outside {
+			info += fmt.Sprintf("\n   Outside stud | Fee: $%d", sire.Fee)
+		} else {
+			info += "\n   Your stud | Fee: Free"
+		}
+
+		b.WriteString(RenderCard(info, m.sireCursor == i))
+		b.WriteString("\n")
+	}
+
+	if len(m.sires) > m.maxVisible {
+		b.WriteString(RenderInfo(scrollInfo(m.sireStart, viewEnd, len(m.sires), "stallions")))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(RenderHelp("Enter to select stallion, ↑/↓ to navigate, ESC to go back"))
+
+	return lipgloss.NewStyle().Padding(1, 2).Render(b.String())
+}
+
+func (m BreedingModel) renderConfirmView() string {
+	var b strings.Builder
+
+	b.WriteString(RenderTitle("Confirm Breeding"))
+	b.WriteString("\n\n")
+
+	sire := m.sires[m.sireCursor]
+	dam := m.dams[m.damCursor]
+
+	info := fmt.Sprintf("Sire: %s (%s)\n", sire.Horse.Name, sire.Horse.Breed)
+	info += fmt.Sprintf("Dam:  %s (%s)\n\n", dam.Horse.Name, dam.Horse.Breed)
+	info += "The foal inherits its parents' natural ability,\n"
+	info += "distance aptitudes and breed, with some variance."
+	if sire.Fee > 0 {
+		info += fmt.Sprintf("\n\nStud Fee: $%d", sire.Fee)
+	}
+
+	b.WriteString(cardStyle.Render(info))
+	b.WriteString("\n\n")
+
+	if m.message != "" {
+		b.WriteString(RenderError(m.message))
+		b.WriteString("\n\n")
+	}
+
+	b.WriteString(RenderButton("Breed (Enter)", true))
+	b.WriteString("\n\n")
+	b.WriteString(RenderHelp("Enter to confirm, ESC to go back"))
+
+	return lipgloss.NewStyle().Padding(1, 2).Render(b.String())
+}
+
+func (m BreedingModel) renderFoalView() string {
+	var b strings.Builder
+
+	b.WriteString(RenderTitle("🍼 A Foal is Born!"))
+	b.WriteString("\n\n")
+
+	foal := m.foal
+	b.WriteString(RenderSuccess(fmt.Sprintf("Welcome %s to the stable!", foal.Name)))
+	b.WriteString("\n\n")
+
+	info := fmt.Sprintf("%s %s (%s)\n", foal.Name, foal.Sex.Icon(), foal.Breed)
+	info += fmt.Sprintf("By %s out of %s (Generation %d)\n\n", foal.Pedigree.SireName, foal.Pedigree.DamName, foal.Pedigree.Generation)
+	info += fmt.Sprintf("Stamina: %d/%d | Speed: %d/%d\n", foal.Stamina, foal.MaxStamina, foal.Speed, foal.MaxSpeed)
+	info += fmt.Sprintf("Technique: %d/%d | Mental: %d/%d\n", foal.Technique, foal.MaxTechnique, foal.Mental, foal.MaxMental)
+	info += "Aptitude: " + renderAptitudes(foal.Aptitudes)
+
+	b.WriteString(RenderCard(info, true))
+	b.WriteString("\n\n")
+	b.WriteString(RenderInfo("The foal will be waiting for you in Scout Horse."))
+	b.WriteString("\n\n")
+	b.WriteString(RenderHelp("Enter to continue"))
+
+	return lipgloss.NewStyle().Padding(1, 2).Render(b.String())
+}
+
+func renderBreedingCandidate(horse models.Horse) string {
+	info := fmt.Sprintf("%s %s (%s) | Rating: %d", horse.Name, horse.Sex.Icon(), horse.Breed, horse.GetOverallRating())
+	info += fmt.Sprintf("\n   STA %d | SPD %d | TEC %d | MEN %d", horse.Stamina, horse.Speed, horse.Technique, horse.Mental)
+	if !horse.Aptitudes.IsZero() {
+		info += "\n   Aptitude: " + renderAptitudes(horse.Aptitudes)
+	}
+	return info
+}
+
+func renderAptitudes(aptitudes models.Aptitudes) string {
+	return fmt.Sprintf("Sprint %s | Mile %s | Medium %s | Long %s",
+		models.AptitudeGrade(aptitudes.Sprint), models.AptitudeGrade(aptitudes.Mile),
+		models.AptitudeGrade(aptitudes.Medium), models.AptitudeGrade(aptitudes.Long))
+}
+
+// renderGrowthProfile shows a breed's training gain multipliers as percentages
+func renderGrowthProfile(profile models.GrowthProfile) string {
+	return fmt.Sprintf("Stamina %.0f%% | Speed %.0f%% | Technique %.0f%% | Mental %.0f%%",
+		profile.Stamina*100, profile.Speed*100, profile.Technique*100, profile.Mental*100)
+}
+
+// scrollInfo describes which part of a scrolling list is on screen
+func scrollInfo(start, end, total int, noun string) string {
+	info := fmt.Sprintf("Showing %d-%d of %d %s", start+1, end, total, noun)
+	if start > 0 {
+		info += " ↑"
+	}
+	if end < total {
+		info += " ↓"
+	}
+	return info
+}
@@ -0,0 +1,260 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"goderby/internal/models"
+)
+
+type EconomyModel struct {
+	gameState    *models.GameState
+	selectedPage int // 0 = profit & loss, 1 = ledger, 2 = balance chart
+	season       int // Season shown on the profit & loss page
+	cursor       int // Ledger scroll position (0 = most recent)
+	maxVisible   int // Maximum ledger entries visible at once
+}
+
+func NewEconomyModel(gameState *models.GameState) EconomyModel {
+	return EconomyModel{
+		gameState:    gameState,
+		selectedPage: 0,
+		season:       gameState.Season.Number,
+		cursor:       0,
+		maxVisible:   10,
+	}
+}
+
+func (m EconomyModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m EconomyModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q", "esc":
+			return m, func() tea.Msg {
+				return NavigationMsg{State: MainMenuView}
+			}
+		case "tab":
+			m.selectedPage = (m.selectedPage + 1) % 3
+			m.cursor = 0
+		case "left", "h":
+			if m.selectedPage == 0 && m.season > 1 {
+				m.season--
+			}
+		case "right", "l":
+			if m.selectedPage == 0 && m.season < m.gameState.Season.Number {
+				m.season++
+			}
+		case "up", "k":
+			if m.selectedPage == 1 && m.cursor > 0 {
+				m.cursor--
+			}
+		case "down", "j":
+			if m.selectedPage == 1 && m.cursor < len(m.gameState.Ledger)-m.maxVisible {
+				m.cursor++
+			}
+		}
+	}
+
+	return m, nil
+}
+
+func (m EconomyModel) View() string {
+	var b strings.Builder
+
+	b.WriteString(RenderTitle("💰 Stable Finances"))
+	b.WriteString("\n\n")
+
+	b.WriteString(cardStyle.Render(fmt.Sprintf("Treasury: $%d | Transactions: %d", m.gameState.Treasury, len(m.gameState.Ledger))))
+	b.WriteString("\n\n")
+
+	// Tab navigation
+	tabStyle := lipgloss.NewStyle().
+		Padding(0, 2).
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("#DDA0DD")).
+		Foreground(lipgloss.Color("#FFFFFF"))
+
+	activeTabStyle := tabStyle.
+		BorderForeground(lipgloss.Color("#7ED321")).
+		Background(lipgloss.Color("#7ED321")).
+		Foreground(lipgloss.Color("#FFFFFF"))
+
+	tabNames := []string{"Profit & Loss", "Ledger", "Balance"}
+	var tabs []string
+	for i, name := range tabNames {
+		if i > 0 {
+			tabs = append(tabs, "  ")
+		}
+		if i == m.selectedPage {
+			tabs = append(tabs, activeTabStyle.Render(name))
+		} else {
+			tabs = append(tabs, tabStyle.Render(name))
+		}
+	}
+	b.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, tabs...))
+	b.WriteString("\n\n")
+
+	switch m.selectedPage {
+	case 1:
+		b.WriteString(m.renderLedger())
+	case 2:
+		b.WriteString(m.renderBalanceChart())
+	default:
+		b.WriteString(m.renderProfitAndLoss())
+	}
+
+	b.WriteString("\n\n")
+	switch m.selectedPage {
+	case 0:
+		b.WriteString(RenderHelp("←/→: Change season | Tab: Switch tabs | ESC/q: Back to menu"))
+	case 1:
+		b.WriteString(RenderHelp("↑/↓: Scroll | Tab: Switch tabs | ESC/q: Back to menu"))
+	default:
+		b.WriteString(RenderHelp("Tab: Switch tabs | ESC/q: Back to menu"))
+	}
+
+	return lipgloss.NewStyle().Padding(1, 2).Render(b.String())
+}
+
+func (m EconomyModel) renderProfitAndLoss() string {
+	var b strings.Builder
+
+	finances := m.gameState.GetSeasonFinances(m.season)
+
+	b.WriteString(RenderHeader(fmt.Sprintf("Season %d Profit & Loss", m.season)))
+	b.WriteString("\n")
+
+	var report strings.Builder
+	report.WriteString("Income\n")
+	if len(finances.Income) == 0 {
+		report.WriteString("   (none)\n")
+	}
+	for category := models.EntryFeeExpense; category <= models.LastLedgerCategory; category++ {
+		if amount, ok := finances.Income[category]; ok {
+			report.WriteString(fmt.Sprintf("   %-16s +$%d\n", category.String(), amount))
+		}
+	}
+	report.WriteString(fmt.Sprintf("   %-16s +$%d\n\n", "Total", finances.TotalIncome()))
+
+	report.WriteString("Expenses\n")
+	if len(finances.Expenses) == 0 {
+		report.WriteString("   (none)\n")
+	}
+	for category := models.EntryFeeExpense; category <= models.LastLedgerCategory; category++ {
+		if amount, ok := finances.Expenses[category]; ok {
+			report.WriteString(fmt.Sprintf("   %-16s -$%d\n", category.String(), amount))
+		}
+	}
+	report.WriteString(fmt.Sprintf("   %-16s -$%d\n\n", "Total", finances.TotalExpenses()))
+
+	net := finances.NetProfit()
+	if net >= 0 {
+		report.WriteString(fmt.Sprintf("Net Profit: +$%d", net))
+	} else {
+		report.WriteString(fmt.Sprintf("Net Loss: -$%d", -net))
+	}
+
+	b.WriteString(cardStyle.Render(report.String()))
+	return b.String()
+}
+
+func (m EconomyModel) renderLedger() string {
+	var b strings.Builder
+
+	b.WriteString(RenderHeader("Transaction Ledger"))
+	b.WriteString("\n")
+
+	if len(m.gameState.Ledger) == 0 {
+		b.WriteString(RenderInfo("No transactions yet."))
+		return b.String()
+	}
+
+	// Most recent transactions first
+	var rows strings.Builder
+	shown := 0
+	for i := len(m.gameState.Ledger) - 1 - m.cursor; i >= 0 && shown < m.maxVisible; i-- {
+		entry := m.gameState.Ledger[i]
+		amount := fmt.Sprintf("+$%d", entry.Amount)
+		if entry.Amount < 0 {
+			amount = fmt.Sprintf("-$%d", -entry.Amount)
+		}
+		rows.WriteString(fmt.Sprintf("S%d W%-2d %-15s %-32s %10s  $%d\n",
+			entry.Season, entry.Week, entry.Category.String(), truncate(entry.Description, 32), amount, entry.Balance))
+		shown++
+	}
+
+	b.WriteString(cardStyle.Render(strings.TrimRight(rows.String(), "\n")))
+
+	if len(m.gameState.Ledger) > m.maxVisible {
+		b.WriteString("\n")
+		b.WriteString(RenderInfo(fmt.Sprintf("Showing %d-%d of %d transactions",
+			m.cursor+1, m.cursor+shown, len(m.gameState.Ledger))))
+	}
+
+	return b.String()
+}
+
+// renderBalanceChart draws the treasury balance after each of the most recent transactions
+func (m EconomyModel) renderBalanceChart() string {
+	const chartHeight = 8
+	const chartWidth = 48
+
+	var b strings.Builder
+
+	b.WriteString(RenderHeader("Running Balance"))
+	b.WriteString("\n")
+
+	ledger := m.gameState.Ledger
+	if len(ledger) == 0 {
+		b.WriteString(RenderInfo("No transactions yet."))
+		return b.String()
+	}
+
+	start := max(len(ledger)-chartWidth, 0)
+	points := ledger[start:]
+
+	low, high := points[0].Balance, points[0].Balance
+	for _, entry := range points {
+		low = min(low, entry.Balance)
+		high = max(high, entry.Balance)
+	}
+	low = min(low, 0)
+	span := max(high-low, 1)
+
+	var chart strings.Builder
+	for row := chartHeight; row >= 1; row-- {
+		threshold := low + span*row/chartHeight
+		label := fmt.Sprintf("%9s │", fmt.Sprintf("$%d", threshold))
+		chart.WriteString(label)
+		for _, entry := range points {
+			level := (entry.Balance - low) * chartHeight / span
+			if level >= row {
+				chart.WriteString("█")
+			} else {
+				chart.WriteString(" ")
+			}
+		}
+		chart.WriteString("\n")
+	}
+	chart.WriteString(fmt.Sprintf("%9s └%s\n", fmt.Sprintf("$%d", low), strings.Repeat("─", len(points))))
+	chart.WriteString(fmt.Sprintf("%11sS%d W%d → S%d W%d", "",
+		points[0].Season, points[0].Week, points[len(points)-1].Season, points[len(points)-1].Week))
+
+	b.WriteString(cardStyle.Render(chart.String()))
+	return b.String()
+}
+
+// truncate shortens a string to at most n runes
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n-1]) + "…"
+}
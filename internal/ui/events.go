@@ -0,0 +1,182 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"goderby/internal/models"
+)
+
+// EventModel pauses season progression until the player decides on the pending event
+type EventModel struct {
+	gameState *models.GameState
+	returnTo  ViewState
+	cursor    int
+	outcome   string // Set once the decision has been made
+	isError   bool
+	kind      models.EventType
+}
+
+func NewEventModel(gameState *models.GameState, returnTo ViewState) EventModel {
+	kind := models.SeasonEvent
+	if gameState.PendingEvent != nil {
+		// Training and race events left undecided when the game was closed wait here too
+		kind = gameState.PendingEvent.Type
+	}
+	return EventModel{
+		gameState: gameState,
+		returnTo:  returnTo,
+		kind:      kind,
+	}
+}
+
+func (m EventModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m EventModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		// Once decided, any confirming key continues on
+		if m.outcome != "" || m.gameState.PendingEvent == nil {
+			switch msg.String() {
+			case "enter", " ", "esc", "q":
+				return m, func() tea.Msg {
+					return NavigationMsg{State: m.returnTo}
+				}
+			}
+			return m, nil
+		}
+
+		switch msg.String() {
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case "down", "j":
+			if m.cursor < len(m.gameState.PendingEvent.Choices)-1 {
+				m.cursor++
+			}
+		case "enter", " ":
+			horse := m.gameState.GetStableHorse(m.gameState.PendingEventHorseID)
+			choice, err := m.gameState.ResolvePendingEvent(m.cursor)
+			if err != nil {
+				m.outcome = err.Error()
+				m.isError = true
+				return m, nil
+			}
+			m.outcome = fmt.Sprintf("%s: %s", horse.Name, choice.Text)
+			if effects := describeEffects(choice.Effects); effects != "" {
+				m.outcome += " (" + effects + ")"
+			}
+			if choice.Hint != "" {
+				m.outcome += " 💡 " + horse.SkillHintStatus(choice.Hint)
+			}
+		}
+	}
+
+	return m, nil
+}
+
+func (m EventModel) View() string {
+	var b strings.Builder
+
+	b.WriteString(RenderTitle(fmt.Sprintf("📰 %s Event", m.kind.String())))
+	b.WriteString("\n\n")
+
+	if m.outcome != "" {
+		if m.isError {
+			b.WriteString(RenderError(m.outcome))
+		} else {
+			b.WriteString(RenderSuccess(m.outcome))
+		}
+		b.WriteString("\n\n")
+		b.WriteString(RenderHelp("Enter to continue"))
+		return lipgloss.NewStyle().Padding(1, 2).Render(b.String())
+	}
+
+	event := m.gameState.PendingEvent
+	if event == nil {
+		b.WriteString(RenderInfo("Nothing needs your attention right now."))
+		b.WriteString("\n\n")
+		b.WriteString(RenderHelp("Enter to continue"))
+		return lipgloss.NewStyle().Padding(1, 2).Render(b.String())
+	}
+
+	if horse := m.gameState.GetStableHorse(m.gameState.PendingEventHorseID); horse != nil {
+		b.WriteString(cardStyle.Render(fmt.Sprintf("🐎 %s | Season %d - Week %d",
+			horse.Name, m.gameState.Season.Number, m.gameState.Season.CurrentWeek)))
+		b.WriteString("\n\n")
+	}
+
+	b.WriteString(renderEventChoices(event, m.cursor))
+	b.WriteString("\n\n")
+	b.WriteString(RenderHelp("↑/↓ to choose, Enter to decide"))
+
+	return lipgloss.NewStyle().Padding(1, 2).Render(b.String())
+}
+
+// renderEventChoices draws an event and its options with the cursor on the selected choice
+func renderEventChoices(event *models.Event, cursor int) string {
+	var b strings.Builder
+
+	b.WriteString(RenderHeader(event.Name))
+	b.WriteString("\n")
+	b.WriteString(event.Description)
+	b.WriteString("\n\n")
+
+	for i, choice := range event.Choices {
+		marker := " "
+		if cursor == i {
+			marker = ">"
+		}
+		info := fmt.Sprintf("%s %s", marker, choice.Text)
+		if effects := describeEffects(choice.Effects); effects != "" {
+			info += "\n   " + effects
+		}
+		if skill, ok := models.GetSkill(choice.Skill); ok {
+			info += fmt.Sprintf("\n   Teaches %s %s", skill.Icon, skill.Name)
+		}
+		if skill, ok := models.GetSkill(choice.Hint); ok {
+			info += fmt.Sprintf("\n   Skill hint: %s %s", skill.Icon, skill.Name)
+		}
+		b.WriteString(RenderCard(info, cursor == i))
+		if i < len(event.Choices)-1 {
+			b.WriteString("\n")
+		}
+	}
+
+	return b.String()
+}
+
+// describeEffects formats stat changes as "+8 Stamina, -5 Morale"
+func describeEffects(effects map[string]int) string {
+	names := map[string]string{
+		"stamina":     "Stamina",
+		"speed":       "Speed",
+		"technique":   "Technique",
+		"mental":      "Mental",
+		"morale":      "Morale",
+		"fatigue":     "Fatigue",
+		"fan_support": "Fans",
+	}
+
+	keys := make([]string, 0, len(effects))
+	for key := range effects {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		name, ok := names[key]
+		if !ok {
+			name = key
+		}
+		parts = append(parts, fmt.Sprintf("%+d %s", effects[key], name))
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,381 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"goderby/internal/models"
+)
+
+type HomesMode int
+
+const (
+	HomesList HomesMode = iota
+	HomeResidents
+	MoveResident
+)
+
+type HomesModel struct {
+	gameState      *models.GameState
+	mode           HomesMode
+	cursor         int // Selected home
+	residentCursor int // Selected resident of the home
+	targetCursor   int // Destination home when moving a resident
+	message        string
+	isError        bool
+}
+
+func NewHomesModel(gameState *models.GameState) HomesModel {
+	return HomesModel{
+		gameState: gameState,
+		mode:      HomesList,
+	}
+}
+
+func (m HomesModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m HomesModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if msg.String() == "ctrl+c" || msg.String() == "q" {
+			return m, func() tea.Msg {
+				return NavigationMsg{State: MainMenuView}
+			}
+		}
+
+		switch m.mode {
+		case HomeResidents:
+			return m.updateResidents(msg)
+		case MoveResident:
+			return m.updateMove(msg)
+		default:
+			return m.updateHomes(msg)
+		}
+	}
+
+	return m, nil
+}
+
+func (m HomesModel) updateHomes(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	homes := m.gameState.RetirementHomes
+
+	switch msg.String() {
+	case "esc":
+		return m, func() tea.Msg {
+			return NavigationMsg{State: MainMenuView}
+		}
+	case "up", "k":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case "down", "j":
+		if m.cursor < len(homes)-1 {
+			m.cursor++
+		}
+	case "b":
+		if m.cursor >= len(homes) {
+			return m, nil
+		}
+		home := homes[m.cursor]
+		if err := m.gameState.PurchaseRetirementHome(home.ID); err != nil {
+			m.message = fmt.Sprintf("Cannot buy %s: %s", home.Name, err.Error())
+			m.isError = true
+		} else {
+			m.message = fmt.Sprintf("Purchased %s for $%d!", home.Name, home.Cost)
+			m.isError = false
+		}
+	case "u":
+		if m.cursor >= len(homes) {
+			return m, nil
+		}
+		home := homes[m.cursor]
+		cost := home.UpgradeCost()
+		if err := m.gameState.UpgradeRetirementHome(home.ID); err != nil {
+			m.message = fmt.Sprintf("Cannot upgrade %s: %s", home.Name, err.Error())
+			m.isError = true
+		} else {
+			upgraded := m.gameState.GetRetirementHome(home.ID)
+			m.message = fmt.Sprintf("%s upgraded for $%d! Capacity is now %d horses", home.Name, cost, upgraded.Capacity)
+			m.isError = false
+		}
+	case "enter", " ":
+		if m.cursor >= len(homes) {
+			return m, nil
+		}
+		if len(m.residents()) == 0 {
+			m.message = fmt.Sprintf("No horses live at %s", homes[m.cursor].Name)
+			m.isError = true
+			return m, nil
+		}
+		m.mode = HomeResidents
+		m.residentCursor = 0
+		m.message = ""
+	}
+
+	return m, nil
+}
+
+func (m HomesModel) updateResidents(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	residents := m.residents()
+
+	switch msg.String() {
+	case "esc":
+		m.mode = HomesList
+		m.message = ""
+	case "up", "k":
+		if m.residentCursor > 0 {
+			m.residentCursor--
+		}
+	case "down", "j":
+		if m.residentCursor < len(residents)-1 {
+			m.residentCursor++
+		}
+	case "m", "enter", " ":
+		if m.residentCursor >= len(residents) {
+			return m, nil
+		}
+		if len(m.moveTargets()) == 0 {
+			m.message = "You need to own another retirement home to move horses"
+			m.isError = true
+			return m, nil
+		}
+		m.mode = MoveResident
+		m.targetCursor = 0
+		m.message = ""
+	}
+
+	return m, nil
+}
+
+func (m HomesModel) updateMove(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	targets := m.moveTargets()
+
+	switch msg.String() {
+	case "esc":
+		m.mode = HomeResidents
+		m.message = ""
+	case "up", "k":
+		if m.targetCursor > 0 {
+			m.targetCursor--
+		}
+	case "down", "j":
+		if m.targetCursor < len(targets)-1 {
+			m.targetCursor++
+		}
+	case "enter", " ":
+		residents := m.residents()
+		if m.targetCursor >= len(targets) || m.residentCursor >= len(residents) {
+			return m, nil
+		}
+		retired := residents[m.residentCursor]
+		target := targets[m.targetCursor]
+
+		if err := m.gameState.MoveRetiredHorse(retired.Horse.ID, target.ID); err != nil {
+			m.message = fmt.Sprintf("Cannot move %s: %s", retired.Horse.Name, err.Error())
+			m.isError = true
+			return m, nil
+		}
+
+		m.message = fmt.Sprintf("%s moved to %s", retired.Horse.Name, target.Name)
+		m.isError = false
+		m.mode = HomeResidents
+		if len(m.residents()) == 0 {
+			m.mode = HomesList
+		}
+		m.residentCursor = min(m.residentCursor, max(len(m.residents())-1, 0))
+	}
+
+	return m, nil
+}
+
+// selectedHome returns the home under the cursor
+func (m HomesModel) selectedHome() *models.RetirementHome {
+	if m.cursor >= len(m.gameState.RetirementHomes) {
+		return nil
+	}
+	return &m.gameState.RetirementHomes[m.cursor]
+}
+
+// residents returns the retired horses living at the selected home
+func (m HomesModel) residents() []models.RetiredHorse {
+	home := m.selectedHome()
+	if home == nil {
+		return nil
+	}
+
+	var residents []models.RetiredHorse
+	for _, retired := range m.gameState.RetiredHorses {
+		if retired.RetirementHome.ID == home.ID {
+			residents = append(residents, retired)
+		}
+	}
+	return residents
+}
+
+// moveTargets returns the other owned homes a resident can move to
+func (m HomesModel) moveTargets() []models.RetirementHome {
+	home := m.selectedHome()
+
+	var targets []models.RetirementHome
+	for _, candidate := range m.gameState.RetirementHomes {
+		if candidate.IsOwned && (home == nil || candidate.ID != home.ID) {
+			targets = append(targets, candidate)
+		}
+	}
+	return targets
+}
+
+func (m HomesModel) View() string {
+	var b strings.Builder
+
+	b.WriteString(RenderTitle("🏠 Retirement Homes"))
+	b.WriteString("\n\n")
+
+	income, fame := m.gameState.GetMonthlyPassiveGains()
+	summary := fmt.Sprintf("Treasury: $%d | Retired: %d | Upkeep: $%d/week\nPassive: $%d & %d fans/month",
+		m.gameState.Treasury, len(m.gameState.RetiredHorses), m.gameState.GetWeeklyHomeUpkeep(), income, fame)
+	b.WriteString(cardStyle.Render(summary))
+	b.WriteString("\n\n")
+
+	switch m.mode {
+	case HomeResidents:
+		b.WriteString(m.renderResidents())
+	case MoveResident:
+		b.WriteString(m.renderMoveTargets())
+	default:
+		b.WriteString(m.renderHomes())
+	}
+
+	if m.message != "" {
+		b.WriteString("\n\n")
+		if m.isError {
+			b.WriteString(RenderError(m.message))
+		} else {
+			b.WriteString(RenderSuccess(m.message))
+		}
+	}
+
+	b.WriteString("\n\n")
+	switch m.mode {
+	case HomeResidents:
+		b.WriteString(RenderHelp("↑/↓: Select horse | m/Enter: Move horse | ESC: Back to homes"))
+	case MoveResident:
+		b.WriteString(RenderHelp("↑/↓: Select destination | Enter: Move here | ESC: Cancel"))
+	default:
+		b.WriteString(RenderHelp("↑/↓: Select home | Enter: Residents | b: Buy | u: Upgrade | ESC/q: Back to menu"))
+	}
+
+	return lipgloss.NewStyle().Padding(1, 2).Render(b.String())
+}
+
+func (m HomesModel) renderHomes() string {
+	var b strings.Builder
+
+	for i, home := range m.gameState.RetirementHomes {
+		cursor := " "
+		if m.cursor == i {
+			cursor = ">"
+		}
+
+		var info strings.Builder
+		info.WriteString(fmt.Sprintf("%s 🏠 %s (Tier %d)\n", cursor, home.Name, home.Tier))
+
+		switch {
+		case !home.IsUnlocked:
+			info.WriteString(fmt.Sprintf("🔒 Locked - Requires: %s\n", home.Requirement.String()))
+			info.WriteString(m.renderRequirementProgress(home.Requirement))
+		case !home.IsOwned:
+			info.WriteString(fmt.Sprintf("Capacity: %d horses | Upkeep: $%d/week\n", home.Capacity, home.CurrentUpkeep()))
+			info.WriteString(fmt.Sprintf("Income: %.1fx | Fame: %.1fx\n", home.IncomeMultiplier, home.FameMultiplier))
+			if m.gameState.CanAfford(home.Cost) {
+				info.WriteString(fmt.Sprintf("For sale: $%d", home.Cost))
+			} else {
+				info.WriteString(fmt.Sprintf("For sale: $%d (cannot afford)", home.Cost))
+			}
+		default:
+			info.WriteString(fmt.Sprintf("Level %d/%d | Residents: %d/%d | Upkeep: $%d/week\n",
+				home.Level, home.MaxLevel, m.gameState.CountResidents(home.ID), home.Capacity, home.CurrentUpkeep()))
+			info.WriteString(fmt.Sprintf("Income: %.1fx | Fame: %.1fx\n", home.IncomeMultiplier, home.FameMultiplier))
+			if home.Level < home.MaxLevel {
+				info.WriteString(fmt.Sprintf("Next upgrade: $%d (+2 stalls)", home.UpgradeCost()))
+			} else {
+				info.WriteString("Fully upgraded ✓")
+			}
+		}
+
+		b.WriteString(RenderCard(strings.TrimRight(info.String(), "\n"), m.cursor == i))
+		if i < len(m.gameState.RetirementHomes)-1 {
+			b.WriteString("\n")
+		}
+	}
+
+	return b.String()
+}
+
+// renderRequirementProgress shows how close the stable is to unlocking a home
+func (m HomesModel) renderRequirementProgress(req models.HomeRequirement) string {
+	var parts []string
+	if req.MinFans > 0 {
+		parts = append(parts, fmt.Sprintf("Fans %d/%d", min(m.gameState.GameStats.TotalFans, req.MinFans), req.MinFans))
+	}
+	if req.MinG1Wins > 0 {
+		parts = append(parts, fmt.Sprintf("G1 wins %d/%d", min(m.gameState.GameStats.G1Wins, req.MinG1Wins), req.MinG1Wins))
+	}
+	if req.MinLegendaryAwards > 0 {
+		parts = append(parts, fmt.Sprintf("Legendary awards %d/%d", min(m.gameState.CountLegendaryAwards(), req.MinLegendaryAwards), req.MinLegendaryAwards))
+	}
+	return "Progress: " + strings.Join(parts, " | ")
+}
+
+func (m HomesModel) renderResidents() string {
+	var b strings.Builder
+
+	home := m.selectedHome()
+	b.WriteString(RenderHeader(fmt.Sprintf("Residents of %s", home.Name)))
+	b.WriteString("\n")
+
+	for i, retired := range m.residents() {
+		cursor := " "
+		if m.residentCursor == i {
+			cursor = ">"
+		}
+		info := fmt.Sprintf("%s 🐎 %s (%s)\n   Role: %s | $%d & %d fans/month",
+			cursor, retired.Horse.Name, retired.Horse.Breed, retired.PostRetirementRole.String(),
+			retired.PassiveIncome, retired.PassiveFame)
+		b.WriteString(RenderCard(info, m.residentCursor == i))
+		b.WriteString("\n")
+	}
+
+	return strings.TrimRight(b.String(), "\n")
+}
+
+func (m HomesModel) renderMoveTargets() string {
+	var b strings.Builder
+
+	residents := m.residents()
+	if m.residentCursor >= len(residents) {
+		return ""
+	}
+	retired := residents[m.residentCursor]
+
+	b.WriteString(RenderHeader(fmt.Sprintf("Move %s to...", retired.Horse.Name)))
+	b.WriteString("\n")
+
+	for i, target := range m.moveTargets() {
+		cursor := " "
+		if m.targetCursor == i {
+			cursor = ">"
+		}
+		info := fmt.Sprintf("%s 🏠 %s\n   Residents: %d/%d | Income: %.1fx | Fame: %.1fx",
+			cursor, target.Name, m.gameState.CountResidents(target.ID), target.Capacity,
+			target.IncomeMultiplier, target.FameMultiplier)
+		b.WriteString(RenderCard(info, m.targetCursor == i))
+		b.WriteString("\n")
+	}
+
+	return strings.TrimRight(b.String(), "\n")
+}
@@ -0,0 +1,158 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"goderby/internal/models"
+)
+
+// JockeysModel lists the jockeys for hire and books them for the active horse
+type JockeysModel struct {
+	gameState *models.GameState
+	cursor    int
+	message   string
+	isError   bool
+}
+
+func NewJockeysModel(gameState *models.GameState) JockeysModel {
+	return JockeysModel{
+		gameState: gameState,
+	}
+}
+
+func (m JockeysModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m JockeysModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	jockeys := models.AllJockeys()
+	switch keyMsg.String() {
+	case "ctrl+c", "q", "esc":
+		return m, func() tea.Msg {
+			return NavigationMsg{State: MainMenuView}
+		}
+	case "up", "k":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case "down", "j":
+		if m.cursor < len(jockeys)-1 {
+			m.cursor++
+		}
+	case "enter", " ", "r":
+		m.book(jockeys[m.cursor], models.PerRide)
+	case "s":
+		m.book(jockeys[m.cursor], models.PerSeason)
+	case "x":
+		horse := m.gameState.PlayerHorse
+		if horse == nil {
+			break
+		}
+		booking := m.gameState.Booking(horse.ID)
+		if booking == nil {
+			m.message = fmt.Sprintf("%s has no jockey booked", horse.Name)
+			m.isError = true
+			break
+		}
+		if booking.Terms == models.PerSeason && booking.Season == m.gameState.Season.Number {
+			m.message = "retained jockey is contracted until the season ends"
+			m.isError = true
+			break
+		}
+		jockey, _ := models.GetJockey(booking.JockeyID)
+		m.gameState.ReleaseJockey(horse.ID)
+		m.message = fmt.Sprintf("Released %s", jockey.Name)
+		m.isError = false
+	}
+
+	return m, nil
+}
+
+// book hires the jockey for the active horse on the given terms
+func (m *JockeysModel) book(jockey models.Jockey, terms models.JockeyTerms) {
+	horse := m.gameState.PlayerHorse
+	if horse == nil {
+		return
+	}
+	if err := m.gameState.BookJockey(horse.ID, jockey.ID, terms); err != nil {
+		m.message = err.Error()
+		m.isError = true
+		return
+	}
+
+	m.isError = false
+	if terms == models.PerSeason {
+		m.message = fmt.Sprintf("🏇 %s retained to ride %s for $%d this season", jockey.Name, horse.Name, jockey.SeasonFee)
+	} else {
+		m.message = fmt.Sprintf("🏇 %s booked to ride %s at $%d per race", jockey.Name, horse.Name, jockey.RideFee)
+	}
+}
+
+func (m JockeysModel) View() string {
+	var b strings.Builder
+
+	b.WriteString(RenderTitle("Jockeys"))
+	b.WriteString("\n\n")
+
+	b.WriteString(cardStyle.Render(fmt.Sprintf("Treasury: $%d | Season %d - Week %d/%d",
+		m.gameState.Treasury, m.gameState.Season.Number, m.gameState.Season.CurrentWeek, m.gameState.Season.MaxWeeks)))
+	b.WriteString("\n\n")
+
+	horse := m.gameState.PlayerHorse
+	if horse == nil {
+		b.WriteString(RenderInfo("Scout a horse before booking a jockey."))
+		b.WriteString("\n\n")
+		b.WriteString(RenderHelp("ESC: Back"))
+		return lipgloss.NewStyle().Padding(1, 2).Render(b.String())
+	}
+
+	b.WriteString(RenderHeader(fmt.Sprintf("Rider for %s", horse.Name)))
+	b.WriteString("\n")
+	if booking := m.gameState.Booking(horse.ID); booking != nil {
+		jockey, _ := models.GetJockey(booking.JockeyID)
+		terms := booking.Terms.String()
+		if booking.Terms == models.PerSeason {
+			terms = fmt.Sprintf("%s (Season %d)", terms, booking.Season)
+		}
+		b.WriteString(cardStyle.Render(fmt.Sprintf("%s - %s | Rapport: %d/%d",
+			jockey.Name, terms, m.gameState.Rapport(jockey.ID, horse.ID), models.MaxRapport)))
+	} else {
+		b.WriteString(RenderInfo("No jockey booked. A freelancer takes the ride, but gives no whip or lane help."))
+	}
+	b.WriteString("\n\n")
+
+	b.WriteString(RenderHeader("Available Jockeys"))
+	b.WriteString("\n")
+	for i, jockey := range models.AllJockeys() {
+		info := fmt.Sprintf("%s\nWhip: %d | Position: %d | Pace: %d | Rapport: %d/%d\n$%d per ride | $%d season retainer",
+			jockey.Name, jockey.Whip, jockey.Position, jockey.Pace,
+			m.gameState.Rapport(jockey.ID, horse.ID), models.MaxRapport, jockey.RideFee, jockey.SeasonFee)
+		b.WriteString(RenderCard(info, m.cursor == i))
+		b.WriteString("\n")
+	}
+	b.WriteString(RenderInfo("Whip calms a horse under the whip, Position finds the best lane, Pace quickens the finish. Rapport grows with every ride."))
+	b.WriteString("\n")
+
+	if m.message != "" {
+		b.WriteString("\n")
+		if m.isError {
+			b.WriteString(RenderError(m.message))
+		} else {
+			b.WriteString(RenderSuccess(m.message))
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(RenderHelp("↑/↓: Navigate | Enter/r: Book Per Ride | s: Season Retainer | x: Release | ESC: Back"))
+
+	return lipgloss.NewStyle().Padding(1, 2).Render(b.String())
+}
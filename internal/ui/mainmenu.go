@@ -16,12 +16,17 @@ type MainMenuModel struct {
 	selected    bool
 	gameState   *models.GameState
 	gameVersion string
+	notices     []string
 }
 
 func NewMainMenuModel(gameState *models.GameState, gameVersion string) MainMenuModel {
-	choices := []string{"Scout Horse", "Train", "Race", "Supporters", "Horse Spa", "Season Summary", "Save & Quit"}
+	choices := []string{"Scout Horse", "Stable", "Train", "Race", "Supporters", "Supporter Shop", "Horse Spa", "Jockeys", "Sponsors", "Finances", "Retirement Homes", "Season Summary", "Save & Quit"}
 	if gameState.PlayerHorse == nil {
-		choices = []string{"Scout Horse", "Supporters", "Save & Quit"}
+		choices = []string{"Scout Horse", "Supporters", "Supporter Shop", "Finances", "Retirement Homes", "Save & Quit"}
+	}
+	if len(gameState.GetBreedingMares()) > 0 {
+		// Insert before "Save & Quit"
+		choices = append(choices[:len(choices)-1], "Breeding", "Save & Quit")
 	}
 
 	return MainMenuModel{
@@ -30,6 +35,7 @@ func NewMainMenuModel(gameState *models.GameState, gameVersion string) MainMenuM
 		selected:    false,
 		gameState:   gameState,
 		gameVersion: gameVersion,
+		notices:     gameState.TakeNotices(),
 	}
 }
 
@@ -73,6 +79,15 @@ func (m MainMenuModel) View() string {
 	b.WriteString(RenderTitle("Go! Derby " + m.gameVersion))
 	b.WriteString("\n\n")
 
+	// Announcements since the menu was last shown
+	for _, notice := range m.notices {
+		b.WriteString(RenderSuccess(notice))
+		b.WriteString("\n")
+	}
+	if len(m.notices) > 0 {
+		b.WriteString("\n")
+	}
+
 	// Player horse info if available
 	if m.gameState.PlayerHorse != nil {
 		horse := m.gameState.PlayerHorse
@@ -80,10 +95,14 @@ func (m MainMenuModel) View() string {
 		b.WriteString("\n")
 
 		horseInfo := fmt.Sprintf("🐎 %s (%s)\n", horse.Name, horse.Breed)
-		horseInfo += fmt.Sprintf("Age: %d | Rating: %d | Fans: %d\n",
-			horse.Age, horse.GetOverallRating(), horse.FanSupport)
-		horseInfo += fmt.Sprintf("Money: $%d | Wins: %d/%d\n",
-			horse.Money, horse.Wins, horse.Races)
+		if len(m.gameState.Stable) > 1 {
+			horseInfo = fmt.Sprintf("🐎 %s (%s) | Stable: %d/%d\n", horse.Name, horse.Breed,
+				len(m.gameState.Stable), m.gameState.StableCapacity())
+		}
+		horseInfo += fmt.Sprintf("Age: %d | Rating: %d | Fans: %d %s\n",
+			horse.Age, horse.GetOverallRating(), horse.FanSupport, horse.FanTier().Icon)
+		horseInfo += fmt.Sprintf("Treasury: $%d | Wins: %d/%d\n",
+			m.gameState.Treasury, horse.Wins, horse.Races)
 
 		b.WriteString(cardStyle.Render(horseInfo))
 		b.WriteString("\n\n")
@@ -103,16 +122,15 @@ func (m MainMenuModel) View() string {
 		b.WriteString(RenderHeader("Retired Horses"))
 		b.WriteString("\n")
 
-		totalPassiveIncome := 0
-		totalPassiveFame := 0
-		for _, retired := range m.gameState.RetiredHorses {
-			totalPassiveIncome += retired.PassiveIncome
-			totalPassiveFame += retired.PassiveFame
-		}
+		totalPassiveIncome, totalPassiveFame := m.gameState.GetMonthlyPassiveGains()
 
 		retiredInfo := fmt.Sprintf("🏠 %d horses retired\n", len(m.gameState.RetiredHorses))
 		retiredInfo += fmt.Sprintf("💰 $%d/month passive income\n", totalPassiveIncome)
 		retiredInfo += fmt.Sprintf("⭐ %d/month passive fame\n", totalPassiveFame)
+		retiredInfo += fmt.Sprintf("📅 Paid weekly, 1 month = %d weeks\n", models.WeeksPerMonth)
+		if upkeep := m.gameState.GetWeeklyHomeUpkeep(); upkeep > 0 {
+			retiredInfo += fmt.Sprintf("🧾 $%d/week home upkeep\n", upkeep)
+		}
 
 		b.WriteString(cardStyle.Render(retiredInfo))
 		b.WriteString("\n\n")
@@ -160,6 +178,16 @@ const (
 	SpaView
 	SummaryView
 	InfoView
+	BreedingView
+	StableView
+	EconomyView
+	HomesView
+	EventView
+	SkillsView
+	PlannerView
+	ShopView
+	SponsorsView
+	JockeysView
 )
 
 type NavigationMsg struct {
@@ -0,0 +1,348 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"goderby/internal/models"
+	"goderby/internal/planner"
+)
+
+// PlanRaceMsg opens the training planner with a target race
+type PlanRaceMsg struct {
+	RaceID string
+}
+
+// PlannerModel proposes a training schedule toward a target race and lets the player edit it
+type PlannerModel struct {
+	gameState  *models.GameState
+	races      []models.Race
+	raceIndex  int
+	raceWeek   int // Race day is at the end of this week
+	goal       planner.Goal
+	plan       planner.Plan
+	editing    bool
+	stepCursor int
+	message    string
+	isError    bool
+}
+
+func NewPlannerModel(gameState *models.GameState, races []models.Race, raceID string) PlannerModel {
+	m := PlannerModel{
+		gameState: gameState,
+		races:     races,
+		goal:      planner.WinChance,
+	}
+	for i, race := range races {
+		if race.ID == raceID {
+			m.raceIndex = i
+		}
+	}
+	m.raceWeek = m.raceDay()
+	m.optimize()
+	return m
+}
+
+// raceDay returns the week of the target race, from its date when it has one
+func (m PlannerModel) raceDay() int {
+	season := m.gameState.Season
+	week := season.CurrentWeek + 3
+	if len(m.races) > 0 && !m.races[m.raceIndex].Date.IsZero() {
+		week = season.WeekOf(m.races[m.raceIndex].Date)
+	}
+	return min(max(week, season.CurrentWeek), season.MaxWeeks)
+}
+
+func (m PlannerModel) Init() tea.Cmd {
+	return nil
+}
+
+// newPlanner builds a planner for the active horse and target race
+func (m PlannerModel) newPlanner() *planner.Planner {
+	return planner.New(m.gameState, m.gameState.PlayerHorse, m.gameState.GetTrainingSupporters(), m.races[m.raceIndex], m.goal)
+}
+
+// optimize searches a fresh schedule for the current target
+func (m *PlannerModel) optimize() {
+	if m.gameState.PlayerHorse == nil || len(m.races) == 0 {
+		return
+	}
+	slots := planner.Slots(m.gameState, m.gameState.PlayerHorse.ID, m.raceWeek)
+	m.plan = m.newPlanner().Optimize(slots)
+	m.stepCursor = 0
+}
+
+// setStep changes the step under the cursor and re-projects the schedule
+func (m *PlannerModel) setStep(rest bool, trainingType models.TrainingType) {
+	if m.stepCursor >= len(m.plan.Steps) {
+		return
+	}
+	steps := append([]planner.Step(nil), m.plan.Steps...)
+	steps[m.stepCursor].Rest = rest
+	steps[m.stepCursor].TrainingType = trainingType
+	m.plan = m.newPlanner().Project(steps)
+}
+
+func (m PlannerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok || m.gameState.PlayerHorse == nil || len(m.races) == 0 {
+		if ok && (keyMsg.String() == "esc" || keyMsg.String() == "q") {
+			return m, func() tea.Msg {
+				return NavigationMsg{State: RaceView}
+			}
+		}
+		return m, nil
+	}
+
+	if m.editing {
+		m.updateEditing(keyMsg)
+		return m, nil
+	}
+
+	season := m.gameState.Season
+	switch keyMsg.String() {
+	case "ctrl+c", "q", "esc":
+		return m, func() tea.Msg {
+			return NavigationMsg{State: RaceView}
+		}
+	case "left", "h":
+		if m.raceWeek > season.CurrentWeek {
+			m.raceWeek--
+			m.optimize()
+		}
+	case "right", "l":
+		if m.raceWeek < season.MaxWeeks {
+			m.raceWeek++
+			m.optimize()
+		}
+	case "tab":
+		m.raceIndex = (m.raceIndex + 1) % len(m.races)
+		m.raceWeek = m.raceDay()
+		m.optimize()
+	case "shift+tab":
+		m.raceIndex = (m.raceIndex + len(m.races) - 1) % len(m.races)
+		m.raceWeek = m.raceDay()
+		m.optimize()
+	case "g":
+		if m.goal == planner.WinChance {
+			m.goal = planner.Rating
+		} else {
+			m.goal = planner.WinChance
+		}
+		m.optimize()
+	case "e":
+		if len(m.plan.Steps) > 0 {
+			m.editing = true
+			m.message = ""
+		}
+	case "enter":
+		name := "Road to " + m.races[m.raceIndex].Name
+		if err := planner.Schedule(m.gameState, m.gameState.PlayerHorse.ID, name, m.plan); err != nil {
+			m.message = fmt.Sprintf("Cannot schedule plan: %s", err.Error())
+			m.isError = true
+			return m, nil
+		}
+		m.message = fmt.Sprintf("%s scheduled through week %d. Follow it from the training calendar with 'a'.", name, m.raceWeek)
+		m.isError = false
+	}
+
+	return m, nil
+}
+
+// updateEditing handles changing individual days of the proposed schedule
+func (m *PlannerModel) updateEditing(msg tea.KeyMsg) {
+	switch msg.String() {
+	case "esc", "enter", "e":
+		m.editing = false
+	case "left", "h", "up", "k":
+		if m.stepCursor > 0 {
+			m.stepCursor--
+		}
+	case "right", "l", "down", "j":
+		if m.stepCursor < len(m.plan.Steps)-1 {
+			m.stepCursor++
+		}
+	case "1", "2", "3", "4", "5", "6":
+		// Numbers follow the available training types, so 5 and 6 need their facilities
+		types := m.gameState.AvailableTrainingTypes()
+		if idx := int(msg.String()[0] - '1'); idx < len(types) {
+			m.setStep(false, types[idx])
+		}
+	case "r":
+		m.setStep(true, 0)
+	}
+}
+
+func (m PlannerModel) View() string {
+	var b strings.Builder
+
+	b.WriteString(RenderTitle("🧭 Training Planner"))
+	b.WriteString("\n\n")
+
+	horse := m.gameState.PlayerHorse
+	if horse == nil || len(m.races) == 0 {
+		b.WriteString(RenderError("A horse and a target race are needed to plan training."))
+		b.WriteString("\n\n")
+		b.WriteString(RenderHelp("ESC/q to go back"))
+		return lipgloss.NewStyle().Padding(1, 2).Render(b.String())
+	}
+
+	race := m.races[m.raceIndex]
+	target := fmt.Sprintf("🏁 %s (%s, %dm) | Race day: end of week %d | Goal: %s",
+		race.Name, race.Grade.String(), race.Distance, m.raceWeek, m.goal.String())
+	if len(m.plan.Projection) > 0 {
+		final := m.plan.Final()
+		target += fmt.Sprintf("\nProjected rating: %d → %d | Estimated win chance: %.1f%%",
+			m.plan.Projection[0].Rating, final.Rating, m.plan.WinChance*100)
+		if final.Rating < race.MinRating {
+			target += fmt.Sprintf("\nRating %d is needed to enter", race.MinRating)
+		}
+	}
+	b.WriteString(cardStyle.Render(target))
+	b.WriteString("\n\n")
+
+	b.WriteString(RenderHeader("Proposed Schedule"))
+	b.WriteString("\n")
+	b.WriteString(cardStyle.Render(m.renderSchedule()))
+	b.WriteString("\n\n")
+
+	if len(m.plan.Projection) > 1 {
+		b.WriteString(RenderHeader("Projection"))
+		b.WriteString("\n")
+		b.WriteString(cardStyle.Render(renderProjection(m.plan.Projection)))
+		b.WriteString("\n")
+		b.WriteString(RenderHelp("Projections assume no injuries or training events along the way"))
+		b.WriteString("\n\n")
+	}
+
+	if m.message != "" {
+		if m.isError {
+			b.WriteString(RenderError(m.message))
+		} else {
+			b.WriteString(RenderSuccess(m.message))
+		}
+		b.WriteString("\n\n")
+	}
+
+	if m.editing {
+		var keys []string
+		for i, trainingType := range m.gameState.AvailableTrainingTypes() {
+			keys = append(keys, fmt.Sprintf("%d: %s", i+1, trainingType.String()))
+		}
+		b.WriteString(RenderHelp(fmt.Sprintf("←/→ to pick a day, %s, r: Rest, Enter/ESC when done", strings.Join(keys, ", "))))
+	} else {
+		b.WriteString(RenderHelp("←/→ race week, Tab: next race, g: switch goal, e: edit, Enter to accept, ESC/q to go back"))
+	}
+
+	return lipgloss.NewStyle().Padding(1, 2).Render(b.String())
+}
+
+// renderSchedule lists the steps week by week, marking the cursor while editing
+func (m PlannerModel) renderSchedule() string {
+	if len(m.plan.Steps) == 0 {
+		return "No training days left before race day."
+	}
+
+	var lines []string
+	var line strings.Builder
+	week := -1
+	for i, step := range m.plan.Steps {
+		if step.Week != week {
+			if line.Len() > 0 {
+				lines = append(lines, line.String())
+				line.Reset()
+			}
+			week = step.Week
+			line.WriteString(fmt.Sprintf("Week %2d:", week))
+		}
+
+		label := shortStep(step)
+		if m.editing && i == m.stepCursor {
+			line.WriteString(fmt.Sprintf(" [%-4s]", label))
+		} else {
+			line.WriteString(fmt.Sprintf("  %-4s ", label))
+		}
+	}
+	lines = append(lines, line.String())
+
+	return strings.Join(lines, "\n")
+}
+
+// shortStep abbreviates a step for the schedule grid
+func shortStep(step planner.Step) string {
+	if step.Rest {
+		return "Rest"
+	}
+	switch step.TrainingType {
+	case models.StaminaTraining:
+		return "Stam"
+	case models.SpeedTraining:
+		return "Spd"
+	case models.TechniqueTraining:
+		return "Tech"
+	case models.MentalTraining:
+		return "Ment"
+	case models.SwimmingTraining:
+		return "Swim"
+	case models.GatePractice:
+		return "Gate"
+	default:
+		return "?"
+	}
+}
+
+// renderProjection draws a sparkline for each stat across the schedule
+func renderProjection(projection []planner.Snapshot) string {
+	series := []struct {
+		name  string
+		value func(planner.Snapshot) int
+	}{
+		{"Stamina", func(s planner.Snapshot) int { return s.Stamina }},
+		{"Speed", func(s planner.Snapshot) int { return s.Speed }},
+		{"Technique", func(s planner.Snapshot) int { return s.Technique }},
+		{"Mental", func(s planner.Snapshot) int { return s.Mental }},
+		{"Fatigue", func(s planner.Snapshot) int { return s.Fatigue }},
+		{"Morale", func(s planner.Snapshot) int { return s.Morale }},
+	}
+
+	var b strings.Builder
+	for i, s := range series {
+		values := make([]int, len(projection))
+		for j, snap := range projection {
+			values[j] = s.value(snap)
+		}
+		b.WriteString(fmt.Sprintf("%-10s %s %d → %d", s.name, sparkline(values, 48), values[0], values[len(values)-1]))
+		if i < len(series)-1 {
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
+
+// sparkline renders values as block characters, sampling down to at most width columns
+func sparkline(values []int, width int) string {
+	blocks := []rune("▁▂▃▄▅▆▇█")
+
+	if len(values) > width {
+		sampled := make([]int, width)
+		for i := range sampled {
+			sampled[i] = values[i*(len(values)-1)/(width-1)]
+		}
+		values = sampled
+	}
+
+	low, high := values[0], values[0]
+	for _, v := range values {
+		low = min(low, v)
+		high = max(high, v)
+	}
+	span := max(high-low, 1)
+
+	var b strings.Builder
+	for _, v := range values {
+		b.WriteRune(blocks[(v-low)*(len(blocks)-1)/span])
+	}
+	return b.String()
+}
@@ -22,14 +22,17 @@ type RaceModel struct {
 	result            *models.RaceResult
 	liveProgress      []models.RaceProgressUpdate
 	currentTurn       int
-	acquiredSupporter *models.Supporter
+	acquiredSupporter *models.CardDrop // Supporter card won in this race, if any
+	raceEvent         *models.Event    // Pre-race event waiting for a decision
+	eventCursor       int
+	preRaceNote       string // Outcome of the pre-race decision, shown during the race
 	// Interactive racing controls
 	playerLane       int  // Current lane (0-4, left to right)
-	raceStamina      int  // Current race stamina (separate from training fatigue)
 	whipUses         int  // Times whip has been used this race
 	obedienceCounter int  // Counter for disobedience effect
 	isDisobedient    bool // Whether horse is currently disobedient
 	lastWhipTurn     int  // Last turn whip was used
+	lastLaneTurn     int  // Last turn the player changed lanes
 	// Scrolling support
 	viewStart  int // For scrolling through races
 	maxVisible int // Maximum races visible at once
@@ -43,6 +46,7 @@ const (
 	ConfirmingEntry
 	Racing
 	ViewingResult
+	PreRaceEvent
 )
 
 func NewRaceModel(gameState *models.GameState, races []models.Race) RaceModel {
@@ -65,8 +69,7 @@ func NewRaceModel(gameState *models.GameState, races []models.Race) RaceModel {
 			Pace:      models.Even,
 		},
 		mode:             SelectingRace,
-		playerLane:       2,   // Start in middle lane
-		raceStamina:      100, // Start with full race stamina
+		playerLane:       2, // Start in middle lane
 		whipUses:         0,
 		obedienceCounter: 0,
 		isDisobedient:    false,
@@ -83,6 +86,11 @@ func (m RaceModel) Init() tea.Cmd {
 func (m RaceModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		// Pre-race events must be decided before the gates open
+		if m.mode == PreRaceEvent {
+			return m.updateRaceEvent(msg)
+		}
+
 		switch msg.String() {
 		case "ctrl+c", "q":
 			if m.mode == Racing {
@@ -108,6 +116,17 @@ func (m RaceModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					return NavigationMsg{State: MainMenuView}
 				}
 			}
+		case "c":
+			if m.mode == SelectingRace {
+				m.gameState.NextCommentary()
+			}
+		case "o":
+			if m.mode == SelectingRace && len(m.races) > 0 {
+				raceID := m.races[m.selectedRace].ID
+				return m, func() tea.Msg {
+					return PlanRaceMsg{RaceID: raceID}
+				}
+			}
 		case "up", "k":
 			switch m.mode {
 			case SelectingRace:
@@ -175,6 +194,7 @@ func (m RaceModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				// Move left during race
 				if m.playerLane > 0 && !m.isDisobedient {
 					m.playerLane--
+					m.lastLaneTurn = m.currentTurn
 				}
 			}
 		case "right", "l":
@@ -194,6 +214,7 @@ func (m RaceModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				// Move right during race
 				if m.playerLane < 4 && !m.isDisobedient {
 					m.playerLane++
+					m.lastLaneTurn = m.currentTurn
 				}
 			}
 		case "enter", " ":
@@ -206,8 +227,19 @@ func (m RaceModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.mode = ConfirmingEntry
 			case ConfirmingEntry:
 				race := m.races[m.selectedRace]
-				entryFee := race.GetEntryFee()
-				if m.gameState.PlayerHorse.Money >= entryFee {
+				entryFee := race.GetEntryFee() + m.gameState.RideFee(m.gameState.PlayerHorse.ID)
+				if m.gameState.CanAfford(entryFee) {
+					m.preRaceNote = ""
+					if event := m.gameState.RollRaceEvent(m.gameState.PlayerHorse); event != nil {
+						if !event.HasChoices() {
+							m.preRaceNote = event.Description
+							return m.startRace()
+						}
+						m.raceEvent = event
+						m.eventCursor = 0
+						m.mode = PreRaceEvent
+						return m, nil
+					}
 					return m.startRace()
 				}
 				// If can't afford, do nothing (stay in confirm view)
@@ -229,20 +261,15 @@ func (m RaceModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if m.currentTurn < len(m.liveProgress) {
 				m.currentTurn++
 
+				// A booked jockey steers when left to it
+				m.jockeySteer()
+
 				// Apply interactive modifiers to current turn if applicable
 				m.applyInteractiveModifiers()
 
 				// Update obedience state
 				m.updateObedience()
 
-				// Regenerate stamina slowly
-				if m.raceStamina < 100 {
-					m.raceStamina += 2
-					if m.raceStamina > 100 {
-						m.raceStamina = 100
-					}
-				}
-
 				if m.currentTurn < len(m.liveProgress) {
 					return m, tea.Tick(time.Millisecond*1500, func(t time.Time) tea.Msg {
 						return RaceTickMsg{}
@@ -281,6 +308,8 @@ func (m RaceModel) View() string {
 	}
 
 	switch m.mode {
+	case PreRaceEvent:
+		return m.renderRaceEventView()
 	case ViewingResult:
 		return m.renderResultView()
 	case Racing:
@@ -321,9 +350,12 @@ func (m RaceModel) renderRaceListView() string {
 		}
 
 		raceInfo := fmt.Sprintf("%s 🏁 %s (%s)", cursor, race.Name, race.Grade.String())
-		raceInfo += fmt.Sprintf("\n   Distance: %dm | Prize: $%d | Entry Fee: $%d",
-			race.Distance, race.Prize, race.GetEntryFee())
-		raceInfo += fmt.Sprintf("\n   Min Rating: %d", race.MinRating)
+		raceInfo += fmt.Sprintf("\n   Distance: %dm | Course: %s | Prize: $%d | Entry Fee: $%d",
+			race.Distance, race.Course.String(), race.Prize, race.GetEntryFee())
+		raceInfo += fmt.Sprintf("\n   Min Rating: %d | ⚖️ %s: %dkg", race.MinRating, race.Weighting.String(), race.WeightFor(horse))
+		if race.MinFans > 0 {
+			raceInfo += fmt.Sprintf(" | 🎟️ Invitational: %d fans", race.MinFans)
+		}
 
 		if m.selectedRace == i {
 			b.WriteString(RenderCard(raceInfo, true))
@@ -350,7 +382,11 @@ func (m RaceModel) renderRaceListView() string {
 	}
 
 	b.WriteString("\n\n")
-	b.WriteString(RenderHelp("Enter to select race, ↑/↓ to navigate, ESC/q to go back"))
+	if pack := m.gameState.ActiveCommentary(); pack.ID != "" {
+		b.WriteString(RenderInfo(fmt.Sprintf("🎙️ Commentary: %s (%s)", pack.Name, pack.Locale)))
+		b.WriteString("\n")
+	}
+	b.WriteString(RenderHelp("Enter to select race, o: Plan training for this race, c: Change commentary, ↑/↓ to navigate, ESC/q to go back"))
 
 	return lipgloss.NewStyle().Padding(1, 2).Render(b.String())
 }
@@ -368,14 +404,14 @@ func (m RaceModel) renderStrategyView() string {
 	strategyInfo := fmt.Sprintf("Formation: %s | Pace: %s\n\n", m.selectedStrat.Formation.String(), m.selectedStrat.Pace.String())
 
 	strategyInfo += "Formation Effects:\n"
-	strategyInfo += "• Lead: Fast start, maintain position\n"
-	strategyInfo += "• Draft: Stay mid-pack, surge at end\n"
-	strategyInfo += "• Mount: Conservative start, strong finish\n\n"
+	strategyInfo += "• Lead: Spends sprint energy early to get to the front, kicks late\n"
+	strategyInfo += "• Draft: Tucks in behind to save energy, kicks first\n"
+	strategyInfo += "• Mount: Settles at the back, kicks on full reserves\n\n"
 
 	strategyInfo += "Pace Effects:\n"
-	strategyInfo += "• Fast: Quick early pace, may tire\n"
-	strategyInfo += "• Even: Consistent throughout\n"
-	strategyInfo += "• Conserve: Save energy for final push"
+	strategyInfo += "• Fast: Burns energy quickly, may run out\n"
+	strategyInfo += "• Even: Cruises on steady energy\n"
+	strategyInfo += "• Conserve: Slower, but saves energy for the finish"
 
 	b.WriteString(cardStyle.Render(strategyInfo))
 	b.WriteString("\n\n")
@@ -397,9 +433,18 @@ func (m RaceModel) renderConfirmView() string {
 
 	confirmInfo := fmt.Sprintf("Race: %s (%s)\n", race.Name, race.Grade.String())
 	confirmInfo += fmt.Sprintf("Distance: %dm | Prize: $%d\n", race.Distance, race.Prize)
-	confirmInfo += fmt.Sprintf("Entry Fee: $%d\n\n", entryFee)
-	confirmInfo += fmt.Sprintf("Horse: %s (Rating: %d)\n", horse.Name, horse.GetOverallRating())
-	confirmInfo += fmt.Sprintf("Money: $%d\n", horse.Money)
+	confirmInfo += fmt.Sprintf("Course: %s - %s\n", race.Course.String(), race.Course.DrawNote())
+	confirmInfo += fmt.Sprintf("Entry Fee: $%d\n", entryFee)
+	if jockey, ok := m.gameState.JockeyFor(horse.ID); ok {
+		rideFee := m.gameState.RideFee(horse.ID)
+		confirmInfo += fmt.Sprintf("Jockey: %s (ride fee $%d)\n", jockey.Name, rideFee)
+		entryFee += rideFee
+	} else {
+		confirmInfo += "Jockey: none - book one from the Jockeys menu\n"
+	}
+	confirmInfo += fmt.Sprintf("Weights: %s | Carrying: %dkg\n\n", race.Weighting.String(), race.WeightFor(horse))
+	confirmInfo += fmt.Sprintf("Horse: %s (Rating: %d | Temperament: %s)\n", horse.Name, horse.GetOverallRating(), horse.Temperament.String())
+	confirmInfo += fmt.Sprintf("Treasury: $%d\n", m.gameState.Treasury)
 	confirmInfo += fmt.Sprintf("Formation: %s | Pace: %s\n\n",
 		m.selectedStrat.Formation.String(), m.selectedStrat.Pace.String())
 	confirmInfo += "Current Status:\n"
@@ -417,12 +462,12 @@ func (m RaceModel) renderConfirmView() string {
 		b.WriteString(RenderWarning("Warning: Your horse has low morale!"))
 		b.WriteString("\n")
 	}
-	if horse.Money < entryFee {
+	if !m.gameState.CanAfford(entryFee) {
 		b.WriteString(RenderError("Error: Not enough money for entry fee!"))
 		b.WriteString("\n")
 	}
 
-	canAfford := horse.Money >= entryFee
+	canAfford := m.gameState.CanAfford(entryFee)
 	b.WriteString(RenderButton("Enter Race (Enter)", canAfford))
 	b.WriteString("\n\n")
 	if canAfford {
@@ -441,9 +486,14 @@ func (m RaceModel) renderRaceView() string {
 	b.WriteString("\n\n")
 
 	race := m.races[m.selectedRace]
-	b.WriteString(RenderHeader(fmt.Sprintf("%s - Turn %d", race.Name, m.currentTurn)))
+	b.WriteString(RenderHeader(fmt.Sprintf("%s - Turn %d | %s", race.Name, m.currentTurn, race.Weather.String())))
 	b.WriteString("\n\n")
 
+	if m.preRaceNote != "" {
+		b.WriteString(RenderInfo(m.preRaceNote))
+		b.WriteString("\n\n")
+	}
+
 	// Player controls and status
 	b.WriteString(m.renderPlayerStatus())
 	b.WriteString("\n")
@@ -469,6 +519,12 @@ func (m RaceModel) renderRaceView() string {
 			b.WriteString("\n")
 		}
 
+		// Supporters
+		for _, cheer := range progress.Cheers {
+			b.WriteString(RenderSuccess(cheer))
+			b.WriteString("\n")
+		}
+
 		// Events
 		for _, event := range progress.Events {
 			b.WriteString(RenderWarning("⚡ " + event))
@@ -491,16 +547,25 @@ func (m RaceModel) renderAnimatedRaceTrack(progress models.RaceProgressUpdate, r
 		HorseID  string
 		Position int
 		Name     string
+		Jockey   string
 		Distance int
+		Stall    int
+		Break    models.Break
 	}
 
 	var positions []HorsePosition
 	for horseID, position := range progress.Positions {
 		name := horseID
+		jockey := ""
+		stall := 0
+		var start models.Break
 		// Try to find horse name from results
 		for _, entrant := range m.result.Results {
 			if entrant.HorseID == horseID {
 				name = entrant.HorseName
+				jockey = entrant.Jockey
+				stall = entrant.Stall
+				start = entrant.Break
 				break
 			}
 		}
@@ -513,14 +578,18 @@ func (m RaceModel) renderAnimatedRaceTrack(progress models.RaceProgressUpdate, r
 			HorseID:  horseID,
 			Position: position,
 			Name:     name,
+			Jockey:   jockey,
 			Distance: progress.Distances[horseID],
+			Stall:    stall,
+			Break:    start,
 		})
 	}
 
-	// Sort by current distance (race order)
+	// Sort by current distance (race order), then by stall in the gates
 	for i := 0; i < len(positions)-1; i++ {
 		for j := i + 1; j < len(positions); j++ {
-			if positions[i].Distance < positions[j].Distance {
+			if positions[i].Distance < positions[j].Distance ||
+				(positions[i].Distance == positions[j].Distance && positions[i].Position > positions[j].Position) {
 				positions[i], positions[j] = positions[j], positions[i]
 			}
 		}
@@ -602,6 +671,15 @@ func (m RaceModel) renderAnimatedRaceTrack(progress models.RaceProgressUpdate, r
 		}
 
 		positionInfo := fmt.Sprintf(" %d. %s", pos.Position, horseName)
+		if pos.Jockey != "" {
+			positionInfo += fmt.Sprintf(" (%s)", pos.Jockey)
+		}
+		if pos.Stall > 0 && progress.Turn == 0 {
+			positionInfo += fmt.Sprintf(" [Stall %d]", pos.Stall)
+		}
+		if pos.Break.Recovering(progress.Turn) {
+			positionInfo += fmt.Sprintf(" %s %s", pos.Break.Icon(), pos.Break.String())
+		}
 
 		// Highlight player horse
 		if isPlayerHorse {
@@ -651,11 +729,18 @@ func (m RaceModel) renderResultView() string {
 	rewardsInfo := fmt.Sprintf("Prize Money: $%d\n", m.result.PrizeMoney)
 	rewardsInfo += fmt.Sprintf("Fans Gained: %d", m.result.FansGained)
 
+	rewardsInfo += fmt.Sprintf("\nTraining Manuals: +%d", models.RaceMaterials(race.Grade, m.result.PlayerRank))
+	rewardsInfo += fmt.Sprintf("\nScout Tokens: +%d", models.RaceScoutTokens(race.Grade, m.result.PlayerRank, m.result.FansGained))
+
 	// Show acquired supporter if any
-	if m.acquiredSupporter != nil {
-		rewardsInfo += "\n\n🎉 New Supporter Acquired!\n"
-		rewardsInfo += fmt.Sprintf("%s %s\n", m.acquiredSupporter.Rarity.String(), m.acquiredSupporter.Name)
-		rewardsInfo += "📝 " + m.acquiredSupporter.Description
+	if drop := m.acquiredSupporter; drop != nil {
+		if drop.Duplicate {
+			rewardsInfo += "\n\n" + drop.Message()
+		} else {
+			rewardsInfo += "\n\n🎉 New Supporter Acquired!\n"
+			rewardsInfo += fmt.Sprintf("%s %s\n", drop.Supporter.Rarity.String(), drop.Supporter.Name)
+			rewardsInfo += "📝 " + drop.Supporter.Description
+		}
 	}
 
 	b.WriteString(cardStyle.Render(rewardsInfo))
@@ -675,8 +760,14 @@ func (m RaceModel) renderResultView() string {
 			marker = "→ "
 		}
 
-		resultLine := fmt.Sprintf("%s%d. %s (%s)",
-			marker, entrant.Position, entrant.HorseName, entrant.Time)
+		resultLine := fmt.Sprintf("%s%d. %s (%s, %dkg, stall %d)",
+			marker, entrant.Position, entrant.HorseName, entrant.Time, entrant.Weight, entrant.Stall)
+		if entrant.Break != models.CleanBreak {
+			resultLine += fmt.Sprintf(" %s %s", entrant.Break.Icon(), entrant.Break.String())
+		}
+		if entrant.Jockey != "" {
+			resultLine += " ridden by " + entrant.Jockey
+		}
 
 		// Highlight player's horse line
 		if isPlayerHorse {
@@ -692,29 +783,80 @@ func (m RaceModel) renderResultView() string {
 	return lipgloss.NewStyle().Padding(1, 2).Render(b.String())
 }
 
+// updateRaceEvent handles picking an option for a pre-race event, then starts the race
+func (m RaceModel) updateRaceEvent(msg tea.KeyMsg) (RaceModel, tea.Cmd) {
+	switch msg.String() {
+	case "up", "k":
+		if m.eventCursor > 0 {
+			m.eventCursor--
+		}
+	case "down", "j":
+		if m.eventCursor < len(m.raceEvent.Choices)-1 {
+			m.eventCursor++
+		}
+	case "enter", " ":
+		choice, err := m.gameState.ResolvePendingEvent(m.eventCursor)
+		if err != nil {
+			return m, nil
+		}
+
+		m.preRaceNote = fmt.Sprintf("%s: %s", m.raceEvent.Name, choice.Text)
+		m.raceEvent = nil
+		return m.startRace()
+	}
+
+	return m, nil
+}
+
+func (m RaceModel) renderRaceEventView() string {
+	var b strings.Builder
+
+	b.WriteString(RenderTitle("🏇 Before the Race"))
+	b.WriteString("\n\n")
+
+	race := m.races[m.selectedRace]
+	b.WriteString(cardStyle.Render(fmt.Sprintf("%s (%s) | %s", race.Name, race.Grade.String(), m.gameState.PlayerHorse.Name)))
+	b.WriteString("\n\n")
+
+	b.WriteString(renderEventChoices(m.raceEvent, m.eventCursor))
+	b.WriteString("\n\n")
+	b.WriteString(RenderHelp("↑/↓ to choose, Enter to decide and start the race"))
+
+	return lipgloss.NewStyle().Padding(1, 2).Render(b.String())
+}
+
 func (m RaceModel) startRace() (RaceModel, tea.Cmd) {
 	race := m.races[m.selectedRace]
 	entryFee := race.GetEntryFee()
-
-	// Check if player can afford entry fee
-	if m.gameState.PlayerHorse.Money < entryFee {
+	rideFee := m.gameState.RideFee(m.gameState.PlayerHorse.ID)
+	if !m.gameState.CanAfford(entryFee + rideFee) {
 		// Stay in confirm view, the UI will show the error
 		return m, nil
 	}
 
-	// Charge entry fee
-	m.gameState.PlayerHorse.Money -= entryFee
+	// Charge entry and jockey fees
+	if err := m.gameState.Spend(models.EntryFeeExpense, "Entry fee: "+race.Name, entryFee, m.gameState.PlayerHorse.ID); err != nil {
+		return m, nil
+	}
+	if jockey, ok := m.gameState.JockeyFor(m.gameState.PlayerHorse.ID); ok && rideFee > 0 {
+		if err := m.gameState.Spend(models.JockeyExpense, "Ride fee: "+jockey.Name, rideFee, m.gameState.PlayerHorse.ID); err != nil {
+			return m, nil
+		}
+	}
 
 	// Reset acquired supporter for new race
 	m.acquiredSupporter = nil
 
 	// Reset interactive racing controls
-	m.playerLane = 2    // Start in middle lane
-	m.raceStamina = 100 // Full stamina at start
 	m.whipUses = 0
 	m.obedienceCounter = 0
 	m.isDisobedient = false
 	m.lastWhipTurn = 0
+	m.lastLaneTurn = 0
+
+	// Draw race day weather
+	race.Weather = models.RandomWeather()
+	m.races[m.selectedRace].Weather = race.Weather
 
 	// Add player horse to race
 	race.AddEntrant(m.gameState.PlayerHorse.ID)
@@ -723,15 +865,38 @@ func (m RaceModel) startRace() (RaceModel, tea.Cmd) {
 	horses := make(map[string]*models.Horse)
 	horses[m.gameState.PlayerHorse.ID] = m.gameState.PlayerHorse
 
-	// Add AI opponents (simplified)
-	for len(race.Entrants) < race.MaxEntrants && len(race.Entrants) < 8 {
+	// Add AI opponents (simplified), sometimes including the horse's rival
+	rival := m.gameState.PlayerHorse.RivalShowsUp()
+	for len(race.Entrants) < race.FieldSize() {
 		aiHorse := m.generateAIHorse(race)
+		if rival {
+			aiHorse.Name = m.gameState.PlayerHorse.Rival.Name
+			rival = false
+		}
 		horses[aiHorse.ID] = aiHorse
 		race.AddEntrant(aiHorse.ID)
 	}
 
+	// Draw the stalls; the player's horse starts in the lane matching its stall
+	race.DrawGates()
+	m.playerLane = (race.Stall(m.gameState.PlayerHorse.ID) - 1) * 5 / len(race.Entrants)
+
+	// Weigh in the field and put up the jockeys
+	race.AssignWeights(horses)
+	// Freelancers ride every horse without a booked jockey, the player's included
+	riders := make(models.Riders)
+	for id := range horses {
+		riders[id] = models.FreelanceJockey(race.Grade)
+	}
+	if jockey, ok := m.gameState.RidingJockey(m.gameState.PlayerHorse.ID); ok {
+		riders[m.gameState.PlayerHorse.ID] = jockey
+	}
+
 	// Run simulation
 	simulator := game.NewRaceSimulator(race, horses, m.gameState.PlayerHorse.ID, m.selectedStrat)
+	simulator.SetSupporterCheers(m.gameState.SupporterCheers(m.gameState.PlayerHorse))
+	simulator.SetRiders(riders)
+	simulator.SetCommentary(m.gameState.ActiveCommentary())
 	result := simulator.Simulate()
 
 	m.result = &result
@@ -747,13 +912,18 @@ func (m RaceModel) startRace() (RaceModel, tea.Cmd) {
 func (m RaceModel) completeRace() (RaceModel, tea.Cmd) {
 	// Apply race results to player horse
 	horse := m.gameState.PlayerHorse
-	horse.Money += m.result.PrizeMoney
-	horse.FanSupport += m.result.FansGained
+	horse.Money += m.result.PrizeMoney // Career earnings
+	if len(m.races) > m.selectedRace {
+		m.gameState.Earn(models.PrizeIncome, fmt.Sprintf("%s: %s", m.races[m.selectedRace].Name, horse.Name), m.result.PrizeMoney, horse.ID)
+	}
+	m.gameState.AddFans(horse, m.result.FansGained)
 	horse.Races++
 
 	if m.result.PlayerRank == 1 {
 		horse.Wins++
 	}
+	horse.RecordForm(m.result.PlayerRank)
+	m.gameState.RecordRide(horse.ID, m.result.PlayerRank)
 
 	// Apply race results including morale and fatigue changes
 	totalEntrants := len(m.result.Results)
@@ -762,33 +932,27 @@ func (m RaceModel) completeRace() (RaceModel, tea.Cmd) {
 	// Update game stats
 	m.gameState.GameStats.TotalRaces++
 	m.gameState.GameStats.TotalPrizeMoney += m.result.PrizeMoney
-	m.gameState.GameStats.TotalFans += m.result.FansGained
 
 	if m.result.PlayerRank == 1 {
 		m.gameState.GameStats.TotalWins++
+		if len(m.races) > m.selectedRace && m.races[m.selectedRace].Grade >= models.Grade1 {
+			m.gameState.GameStats.G1Wins++
+		}
 	}
 
 	// Record race completion for progression tracking
-	if m.gameState.Season.CompletedRaces == nil {
-		m.gameState.Season.CompletedRaces = make([]string, 0)
-	}
 	if m.gameState.AllCompletedRaces == nil {
 		m.gameState.AllCompletedRaces = make([]string, 0)
 	}
 
 	if len(m.races) > m.selectedRace {
 		raceID := m.races[m.selectedRace].ID
-		// Add to current season
-		m.gameState.Season.CompletedRaces = append(m.gameState.Season.CompletedRaces, raceID)
 
-		// Initialize RaceResults map if nil
-		if m.gameState.Season.RaceResults == nil {
-			m.gameState.Season.RaceResults = make(map[string]models.CompletedRaceResult)
-		}
-
-		// Store the race result with position
-		m.gameState.Season.RaceResults[raceID] = models.CompletedRaceResult{
+		// Store the race result with position; the race is listed once for the season
+		m.gameState.Season.RecordRaceResult(models.CompletedRaceResult{
 			RaceID:        raceID,
+			HorseID:       horse.ID,
+			HorseName:     horse.Name,
 			RaceName:      m.races[m.selectedRace].Name,
 			Grade:         m.races[m.selectedRace].Grade,
 			Distance:      m.races[m.selectedRace].Distance,
@@ -797,7 +961,8 @@ func (m RaceModel) completeRace() (RaceModel, tea.Cmd) {
 			FansGained:    m.result.FansGained,
 			Position:      m.result.PlayerRank,
 			TotalEntrants: len(m.result.Results),
-		}
+			WeightCarried: m.playerWeight(),
+		})
 
 		// Add to global completion tracker if not already present
 		found := false
@@ -811,15 +976,49 @@ func (m RaceModel) completeRace() (RaceModel, tea.Cmd) {
 			m.gameState.AllCompletedRaces = append(m.gameState.AllCompletedRaces, raceID)
 		}
 
-		// Try to acquire supporter based on race performance
-		m.TryAcquireSupporter(m.races[m.selectedRace], m.result.PlayerRank)
+		// Training manuals and a chance at a supporter card based on race performance
+		race := m.races[m.selectedRace]
+		if materials := m.gameState.AwardRaceMaterials(race.Grade, m.result.PlayerRank); materials > 0 {
+			m.gameState.AddNotice(fmt.Sprintf("📘 %s earned %d training manuals", horse.Name, materials))
+		}
+		if tokens := m.gameState.AwardRaceScoutTokens(race.Grade, m.result.PlayerRank, m.result.FansGained); tokens > 0 {
+			m.gameState.AddNotice(fmt.Sprintf("🎟️ %s earned %d scout tokens", horse.Name, tokens))
+		}
+		m.TryAcquireSupporter(race, m.result.PlayerRank)
+
+		// Fans react to streaks, dramatic finishes and rivalries
+		fans, reactions := models.ReactToRace(horse, race, *m.result)
+		for _, reaction := range reactions {
+			m.gameState.AddNotice(reaction)
+		}
+		m.gameState.AddFans(horse, fans)
+
+		for _, message := range m.gameState.EvaluateContracts(horse, &race, m.result.PlayerRank) {
+			m.gameState.AddNotice(message)
+		}
+
+		for _, skill := range horse.CheckRaceSkills(m.races[m.selectedRace], m.result.PlayerRank, m.selectedStrat) {
+			m.gameState.AddNotice(fmt.Sprintf("%s %s learned %s!", skill.Icon, horse.Name, skill.Name))
+		}
 	}
 
+	m.gameState.CheckRetirementHomeUnlocks()
+
 	return m, func() tea.Msg {
 		return NavigationMsg{State: MainMenuView}
 	}
 }
 
+// playerWeight returns the weight the player's horse carried in the race just run
+func (m RaceModel) playerWeight() int {
+	for _, entrant := range m.result.Results {
+		if entrant.HorseID == m.result.PlayerHorse {
+			return entrant.Weight
+		}
+	}
+	return models.StandardWeight
+}
+
 func (m *RaceModel) TryAcquireSupporter(race models.Race, playerRank int) {
 	// Base acquisition chance based on race grade
 	var baseChance float64
@@ -863,19 +1062,9 @@ func (m *RaceModel) TryAcquireSupporter(race models.Race, playerRank int) {
 
 	finalChance := baseChance * positionMultiplier
 
-	// Roll for acquisition
+	// Roll for acquisition; owned cards come back as duplicates
 	if rand.Float64() < finalChance {
-		// Find unowned supporter of target rarity
-		for i := range m.gameState.Supporters {
-			if m.gameState.Supporters[i].Rarity == targetRarity && !m.gameState.Supporters[i].IsOwned {
-				// Mark as owned
-				m.gameState.Supporters[i].IsOwned = true
-
-				// Store acquired supporter info for UI display
-				m.acquiredSupporter = &m.gameState.Supporters[i]
-				break
-			}
-		}
+		m.acquiredSupporter = m.gameState.AcquireSupporter(targetRarity)
 	}
 }
 
@@ -894,21 +1083,30 @@ func (m RaceModel) generateAIHorse(race models.Race) *models.Horse {
 	breed := breeds[rand.Intn(len(breeds))]
 
 	// Generate stats based on race requirements
-	baseRating := race.MinRating + (race.MinRating / 4)
+	rating := race.RivalRating(len(race.Entrants))
 
 	aiHorse := &models.Horse{
 		ID:        fmt.Sprintf("ai_%d", len(race.Entrants)),
 		Name:      name,
 		Breed:     breed,
 		Age:       3,
-		Stamina:   baseRating + (-10 + (len(race.Entrants) * 5)),
-		Speed:     baseRating + (-10 + (len(race.Entrants) * 5)),
-		Technique: baseRating + (-10 + (len(race.Entrants) * 5)),
-		Mental:    baseRating + (-10 + (len(race.Entrants) * 5)),
+		Sex:       models.Sex(rand.Intn(2)),
+		Stamina:   rating,
+		Speed:     rating,
+		Technique: rating,
+		Mental:    rating,
 		Fatigue:   0,
 		Morale:    100,
 	}
 
+	aiHorse.Temperament = models.RandomTemperament()
+
+	// Graded stakes rivals bring a skill of their own
+	if race.Grade >= models.Grade1 {
+		skills := models.AllSkills()
+		aiHorse.LearnSkill(skills[rand.Intn(len(skills))].ID)
+	}
+
 	return aiHorse
 }
 
@@ -918,20 +1116,18 @@ func (m *RaceModel) useWhip() (RaceModel, tea.Cmd) {
 		return *m, nil
 	}
 
-	// Check if enough stamina to whip
-	staminaCost := 25
-	if m.raceStamina < staminaCost {
+	// The whip draws on the horse's sprint reserve in the simulated race
+	if !game.SpendWhip(m.liveProgress, m.currentTurn, m.gameState.PlayerHorse.ID) {
 		return *m, nil
 	}
 
-	// Use whip - consume stamina and track usage
-	m.raceStamina -= staminaCost
+	// Track usage
 	m.whipUses++
 	m.lastWhipTurn = m.currentTurn
 
 	// Enhanced disobedience calculation using horse stats
 	horse := m.gameState.PlayerHorse
-	disobedienceChance := horse.CalculateDisobedienceChance(m.whipUses)
+	disobedienceChance := m.gameState.DisobedienceChance(horse, m.whipUses)
 
 	if rand.Float64() < disobedienceChance {
 		m.isDisobedient = true
@@ -944,6 +1140,31 @@ func (m *RaceModel) useWhip() (RaceModel, tea.Cmd) {
 	return *m, nil
 }
 
+// jockeySteer moves the horse a lane toward the best line when the jockey reads the race
+// and the player hasn't steered for a few turns
+func (m *RaceModel) jockeySteer() {
+	if m.isDisobedient || (m.lastLaneTurn > 0 && m.currentTurn-m.lastLaneTurn < 3) {
+		return
+	}
+	jockey, ok := m.gameState.RidingJockey(m.gameState.PlayerHorse.ID)
+	if !ok || rand.Intn(100) >= jockey.Position {
+		return
+	}
+
+	// Inner rail through the turns, middle of the track on the straights
+	raceProgress := float64(m.currentTurn) / float64(len(m.liveProgress))
+	best := 2
+	if raceProgress <= 0.25 || raceProgress >= 0.75 {
+		best = 0
+	}
+	switch {
+	case m.playerLane > best:
+		m.playerLane--
+	case m.playerLane < best:
+		m.playerLane++
+	}
+}
+
 func (m *RaceModel) updateObedience() {
 	if m.isDisobedient {
 		m.obedienceCounter--
@@ -1080,20 +1301,16 @@ func (m RaceModel) renderPlayerStatus() string {
 		}
 	}
 
-	// Stamina bar
-	staminaBar := ""
-	staminaBars := m.raceStamina / 5 // 20 bars max
-	for i := 0; i < 20; i++ {
-		if i < staminaBars {
-			staminaBar += "█"
-		} else {
-			staminaBar += "▁"
-		}
-	}
+	// Energy bars
+	energy := m.playerEnergy()
 
 	statusInfo := fmt.Sprintf("Lane Position: %s\n", laneDisplay)
-	statusInfo += fmt.Sprintf("Stamina: %s %d/100\n", staminaBar, m.raceStamina)
+	statusInfo += fmt.Sprintf("Energy: %s %d/%d\n", energyBar(energy.AerobicRatio()), energy.Aerobic, energy.MaxAerobic)
+	statusInfo += fmt.Sprintf("Sprint: %s %d/%d\n", energyBar(energy.SprintRatio()), energy.Sprint, energy.MaxSprint)
 	statusInfo += fmt.Sprintf("Whip Uses: %d", m.whipUses)
+	if jockey, ok := m.gameState.JockeyFor(m.gameState.PlayerHorse.ID); ok {
+		statusInfo += fmt.Sprintf(" | Jockey: %s", jockey.Name)
+	}
 
 	if m.isDisobedient {
 		statusInfo += fmt.Sprintf("\n🚫 DISOBEDIENT (%d turns)", m.obedienceCounter)
@@ -1109,6 +1326,21 @@ func (m RaceModel) renderPlayerStatus() string {
 	return statusStyle.Render(statusInfo)
 }
 
+// playerEnergy returns the player's horse's reserves at the current turn
+func (m RaceModel) playerEnergy() models.Energy {
+	if len(m.liveProgress) == 0 {
+		return models.NewEnergy(m.gameState.PlayerHorse)
+	}
+	turn := min(m.currentTurn, len(m.liveProgress)-1)
+	return m.liveProgress[turn].Energy[m.gameState.PlayerHorse.ID]
+}
+
+// energyBar draws a 20-segment bar for the share of a reserve left
+func energyBar(ratio float64) string {
+	filled := min(max(int(ratio*20), 0), 20)
+	return strings.Repeat("█", filled) + strings.Repeat("▁", 20-filled)
+}
+
 func (m RaceModel) renderControlsHelp() string {
 	// Calculate if we're in a turn section for strategic guidance
 	numTurns := len(m.liveProgress)
@@ -1119,13 +1351,13 @@ func (m RaceModel) renderControlsHelp() string {
 	if isInTurn {
 		controlsText = "🎮 IN TURN: Inner lanes (←) give speed advantage! | Enter/W Whip horse | Too much whipping = disobedience!"
 	} else {
-		controlsText = "🎮 Controls: ←/→ Switch lanes | Enter/W Whip horse (+speed, -stamina) | Middle lanes best on straights!"
+		controlsText = "🎮 Controls: ←/→ Switch lanes | Enter/W Whip horse (+speed, -sprint) | Middle lanes best on straights!"
 	}
 
 	if m.isDisobedient {
 		controlsText = "🚫 Horse is disobedient! Controls disabled temporarily."
-	} else if m.raceStamina < 25 {
-		controlsText = "⚠️  Low stamina! Can't use whip until stamina recovers."
+	} else if m.playerEnergy().Sprint < models.WhipCost {
+		controlsText = "⚠️  No sprint left! Can't use whip until your horse gets a breather in behind."
 	} else if m.currentTurn-m.lastWhipTurn < 3 && m.lastWhipTurn > 0 {
 		cooldown := 3 - (m.currentTurn - m.lastWhipTurn)
 		controlsText = fmt.Sprintf("⏳ Whip cooldown: %d turns", cooldown)
@@ -1139,10 +1371,6 @@ func (m RaceModel) GetPlayerLane() int {
 	return m.playerLane
 }
 
-func (m RaceModel) GetRaceStamina() int {
-	return m.raceStamina
-}
-
 func (m RaceModel) GetWhipUses() int {
 	return m.whipUses
 }
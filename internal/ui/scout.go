@@ -18,11 +18,17 @@ type ScoutModel struct {
 	confirmed  bool
 	viewStart  int // For scrolling
 	maxVisible int // Maximum horses visible at once
+	message    string
 }
 
 func NewScoutModel(gameState *models.GameState, horses []models.Horse) ScoutModel {
+	// Foals bred in the stable are offered ahead of the scouted horses
+	pool := make([]models.Horse, 0, len(gameState.Foals)+len(horses))
+	pool = append(pool, gameState.Foals...)
+	pool = append(pool, horses...)
+
 	return ScoutModel{
-		horses:     horses,
+		horses:     pool,
 		cursor:     0,
 		selected:   false,
 		gameState:  gameState,
@@ -44,6 +50,7 @@ func (m ScoutModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "ctrl+c", "q":
 			if m.inspecting {
 				m.inspecting = false
+				m.message = ""
 				return m, nil
 			}
 			return m, func() tea.Msg {
@@ -52,6 +59,7 @@ func (m ScoutModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "esc":
 			if m.inspecting {
 				m.inspecting = false
+				m.message = ""
 				return m, nil
 			}
 			return m, func() tea.Msg {
@@ -75,9 +83,17 @@ func (m ScoutModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		case "enter", " ":
 			if m.inspecting && !m.confirmed {
-				m.confirmed = true
+				if !m.gameState.CanAddHorse() {
+					return m, nil
+				}
 				selectedHorse := m.horses[m.cursor]
-				m.gameState.PlayerHorse = &selectedHorse
+				if err := m.gameState.AddHorseToStable(&selectedHorse); err != nil {
+					m.message = fmt.Sprintf("Could not add %s: %v", selectedHorse.Name, err)
+					return m, nil
+				}
+				m.confirmed = true
+				m.gameState.RemoveFoal(selectedHorse.ID)
+				m.gameState.RemoveAvailableHorse(selectedHorse.ID)
 
 				// Auto-select the 4 Common supporters
 				commonSupporterIDs := []string{"sup_001", "sup_002", "sup_003", "sup_004"}
@@ -132,17 +148,22 @@ func (m ScoutModel) View() string {
 		b.WriteString(RenderCard(horseInfo, true))
 		b.WriteString("\n\n")
 
-		if m.gameState.PlayerHorse == nil {
+		if m.gameState.CanAddHorse() {
 			b.WriteString(RenderButton("Select This Horse (Enter)", true))
 			b.WriteString("\n")
 		}
 		b.WriteString(RenderButton("Back (ESC)", true))
 		b.WriteString("\n\n")
 
-		if m.gameState.PlayerHorse == nil {
+		if m.message != "" {
+			b.WriteString(RenderError(m.message))
+			b.WriteString("\n\n")
+		}
+
+		if m.gameState.CanAddHorse() {
 			b.WriteString(RenderHelp("Enter to select horse, ESC to go back"))
 		} else {
-			b.WriteString(RenderHelp("You already have a horse selected"))
+			b.WriteString(RenderHelp("Your stable is full - upgrade the barn to add more horses"))
 		}
 
 		return lipgloss.NewStyle().Padding(1, 2).Render(b.String())
@@ -152,14 +173,23 @@ func (m ScoutModel) View() string {
 	b.WriteString(RenderTitle("Scout Horses"))
 	b.WriteString("\n\n")
 
-	if m.gameState.PlayerHorse != nil {
-		b.WriteString(RenderWarning("You already have a horse selected"))
+	if !m.gameState.CanAddHorse() {
+		b.WriteString(RenderWarning(fmt.Sprintf("Your stable is full (%d/%d horses)", len(m.gameState.Stable), m.gameState.StableCapacity())))
 		b.WriteString("\n\n")
 	} else {
 		b.WriteString(RenderHeader("Available Horses"))
 		b.WriteString("\n")
-		b.WriteString(RenderInfo("Select a horse to begin your racing career!"))
-		b.WriteString("\n\n")
+		if m.gameState.PlayerHorse == nil {
+			b.WriteString(RenderInfo("Select a horse to begin your racing career!"))
+		} else {
+			b.WriteString(RenderInfo(fmt.Sprintf("Add another horse to your stable (%d/%d stalls used)", len(m.gameState.Stable), m.gameState.StableCapacity())))
+		}
+		b.WriteString("\n")
+		if len(m.gameState.Foals) > 0 {
+			b.WriteString(RenderSuccess(fmt.Sprintf("🍼 %d foal(s) from your breeding program are ready", len(m.gameState.Foals))))
+			b.WriteString("\n")
+		}
+		b.WriteString("\n")
 	}
 
 	// Horse list
@@ -175,7 +205,12 @@ func (m ScoutModel) View() string {
 			cursor = ">"
 		}
 
-		horsePreview := fmt.Sprintf("%s 🐎 %s (%s)", cursor, horse.Name, horse.Breed)
+		horseIcon := "🐎"
+		if m.isFoal(horse) {
+			horseIcon = "🍼"
+		}
+
+		horsePreview := fmt.Sprintf("%s %s %s %s (%s)", cursor, horseIcon, horse.Name, horse.Sex.Icon(), horse.Breed)
 		horsePreview += fmt.Sprintf("\n   Rating: %d | Stamina: %d | Speed: %d | Technique: %d | Mental: %d",
 			horse.GetOverallRating(), horse.Stamina, horse.Speed, horse.Technique, horse.Mental)
 
@@ -205,11 +240,7 @@ func (m ScoutModel) View() string {
 
 	// Help
 	b.WriteString("\n\n")
-	if m.gameState.PlayerHorse == nil {
-		b.WriteString(RenderHelp("Use ↑/↓ to navigate, Enter/i to inspect, ESC/q to go back"))
-	} else {
-		b.WriteString(RenderHelp("Use ↑/↓ to navigate, Enter/i to inspect, ESC/q to go back"))
-	}
+	b.WriteString(RenderHelp("Use ↑/↓ to navigate, Enter/i to inspect, ESC/q to go back"))
 
 	return lipgloss.NewStyle().Padding(1, 2).Render(b.String())
 }
@@ -217,8 +248,17 @@ func (m ScoutModel) View() string {
 func (m ScoutModel) renderHorseDetails(horse models.Horse) string {
 	var details strings.Builder
 
-	details.WriteString(fmt.Sprintf("🐎 %s (%s, Age %d)\n", horse.Name, horse.Breed, horse.Age))
-	details.WriteString(fmt.Sprintf("Overall Rating: %d\n\n", horse.GetOverallRating()))
+	details.WriteString(fmt.Sprintf("🐎 %s (%s %s, Age %d)\n", horse.Name, horse.Sex.String(), horse.Breed, horse.Age))
+	details.WriteString(fmt.Sprintf("Overall Rating: %d | Temperament: %s\n", horse.GetOverallRating(), horse.Temperament.String()))
+	if horse.Pedigree != nil {
+		details.WriteString(fmt.Sprintf("Pedigree: by %s out of %s (Gen %d)\n",
+			horse.Pedigree.SireName, horse.Pedigree.DamName, horse.Pedigree.Generation))
+	}
+	if !horse.Aptitudes.IsZero() {
+		details.WriteString("Aptitude: " + renderAptitudes(horse.Aptitudes) + "\n")
+	}
+	details.WriteString("Growth: " + renderGrowthProfile(models.GetGrowthProfile(horse.Breed)) + "\n")
+	details.WriteString("\n")
 
 	// Stats in compact format
 	details.WriteString("Stats:\n")
@@ -238,6 +278,12 @@ func (m ScoutModel) renderHorseDetails(horse models.Horse) string {
 	return details.String()
 }
 
-type HorseSelectedMsg struct {
-	Horse models.Horse
+// isFoal checks if the horse was bred in the stable and is waiting in the foal pool
+func (m ScoutModel) isFoal(horse models.Horse) bool {
+	for _, foal := range m.gameState.Foals {
+		if foal.ID == horse.ID {
+			return true
+		}
+	}
+	return false
 }
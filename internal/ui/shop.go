@@ -0,0 +1,298 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"goderby/internal/models"
+)
+
+// ShopTab is a page of the supporter shop screen
+type ShopTab int
+
+const (
+	BannerTab ShopTab = iota
+	StoreTab
+	HistoryTab
+)
+
+// ShopModel is the scouting banner, the weekly supporter shop and the draw history
+type ShopModel struct {
+	gameState *models.GameState
+	tab       ShopTab
+	cursor    int
+	lastDraw  []models.ScoutRecord
+	message   string
+	isError   bool
+}
+
+func NewShopModel(gameState *models.GameState) ShopModel {
+	return ShopModel{
+		gameState: gameState,
+	}
+}
+
+func (m ShopModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m ShopModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	switch keyMsg.String() {
+	case "ctrl+c", "q", "esc":
+		return m, func() tea.Msg {
+			return NavigationMsg{State: MainMenuView}
+		}
+	case "tab":
+		m.tab = (m.tab + 1) % 3
+		m.cursor = 0
+		m.message = ""
+	case "shift+tab":
+		m.tab = (m.tab + 2) % 3
+		m.cursor = 0
+		m.message = ""
+	case "up", "k":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case "down", "j":
+		if m.cursor < m.maxCursor() {
+			m.cursor++
+		}
+	case "1":
+		if m.tab == BannerTab {
+			m.scout(1)
+		}
+	case "0":
+		if m.tab == BannerTab {
+			m.scout(models.ScoutMultiCount)
+		}
+	case "enter", " ":
+		if m.tab == StoreTab {
+			m.buy()
+		}
+	}
+
+	return m, nil
+}
+
+// maxCursor is the last selectable row on the current tab
+func (m ShopModel) maxCursor() int {
+	switch m.tab {
+	case StoreTab:
+		return len(m.gameState.ShopStock()) // The manuals bundle follows the cards
+	case HistoryTab:
+		return max(len(m.gameState.ScoutHistory)-1, 0)
+	default:
+		return 0
+	}
+}
+
+// scout draws from the banner and keeps the results for display
+func (m *ShopModel) scout(count int) {
+	records, err := m.gameState.Scout(count)
+	if err != nil {
+		m.message = err.Error()
+		m.isError = true
+		return
+	}
+	m.lastDraw = records
+	m.message = ""
+}
+
+// buy purchases the selected card or the manuals bundle
+func (m *ShopModel) buy() {
+	stock := m.gameState.ShopStock()
+	if m.cursor == len(stock) {
+		if err := m.gameState.BuyManualsBundle(); err != nil {
+			m.message = err.Error()
+			m.isError = true
+			return
+		}
+		size, _ := models.ManualsBundle()
+		m.message = fmt.Sprintf("📘 Bought %d training manuals", size)
+		m.isError = false
+		return
+	}
+
+	drop, err := m.gameState.BuyShopCard(stock[m.cursor].Supporter.ID)
+	if err != nil {
+		m.message = err.Error()
+		m.isError = true
+		return
+	}
+	m.message = drop.Message()
+	m.isError = false
+}
+
+func (m ShopModel) View() string {
+	var b strings.Builder
+
+	b.WriteString(RenderTitle("🎟️ Supporter Shop"))
+	b.WriteString("\n\n")
+
+	tabs := []string{"Scouting Banner", "Weekly Shop", "History"}
+	var rendered []string
+	for i, name := range tabs {
+		style := menuItemStyle
+		if ShopTab(i) == m.tab {
+			style = selectedMenuItemStyle
+		}
+		rendered = append(rendered, style.Render(name))
+	}
+	b.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, rendered...))
+	b.WriteString("\n\n")
+
+	b.WriteString(cardStyle.Render(fmt.Sprintf("🎟️ Scout Tokens: %d | 📘 Training Manuals: %d",
+		m.gameState.ScoutTokens, m.gameState.TrainingManuals)))
+	b.WriteString("\n\n")
+
+	switch m.tab {
+	case StoreTab:
+		b.WriteString(m.renderStore())
+	case HistoryTab:
+		b.WriteString(m.renderHistory())
+	default:
+		b.WriteString(m.renderBanner())
+	}
+
+	if m.message != "" {
+		b.WriteString("\n")
+		if m.isError {
+			b.WriteString(RenderError(m.message))
+		} else {
+			b.WriteString(RenderSuccess(m.message))
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	switch m.tab {
+	case BannerTab:
+		b.WriteString(RenderHelp(fmt.Sprintf("1: Scout once (%d) | 0: Scout x%d (%d) | Tab: Switch tabs | ESC: Back",
+			models.ScoutCost, models.ScoutMultiCount, models.ScoutMultiCost)))
+	case StoreTab:
+		b.WriteString(RenderHelp("↑/↓: Navigate | Enter: Buy | Tab: Switch tabs | ESC: Back"))
+	default:
+		b.WriteString(RenderHelp("↑/↓: Scroll | Tab: Switch tabs | ESC: Back"))
+	}
+
+	return lipgloss.NewStyle().Padding(1, 2).Render(b.String())
+}
+
+func (m ShopModel) renderBanner() string {
+	var b strings.Builder
+
+	b.WriteString(RenderHeader(fmt.Sprintf("Season %d Featured", m.gameState.Season.Number)))
+	b.WriteString("\n")
+	var featured []string
+	for _, supporter := range m.gameState.FeaturedSupporters() {
+		featured = append(featured, fmt.Sprintf("%s %s", supporter.Rarity.String(), supporter.Name))
+	}
+	b.WriteString(cardStyle.Render(strings.Join(featured, "\n") +
+		fmt.Sprintf("\n%.0f%% of each rarity's draws go to its featured card", models.ScoutFeaturedShare*100)))
+	b.WriteString("\n\n")
+
+	b.WriteString(RenderHeader("Rates"))
+	b.WriteString("\n")
+	var rates []string
+	for _, rate := range models.ScoutRates {
+		rates = append(rates, fmt.Sprintf("%-5s %4.1f%%", rate.Rarity.String(), rate.Percent))
+	}
+	rates = append(rates, "",
+		fmt.Sprintf("Pity: an Ultra Rare is guaranteed within %d draws (%d to go)",
+			models.ScoutPityThreshold, m.gameState.DrawsUntilPity()),
+		fmt.Sprintf("A x%d scout always includes a Super Rare or better", models.ScoutMultiCount))
+	b.WriteString(cardStyle.Render(strings.Join(rates, "\n")))
+	b.WriteString("\n")
+
+	if len(m.lastDraw) > 0 {
+		b.WriteString("\n")
+		b.WriteString(RenderHeader("Results"))
+		b.WriteString("\n")
+		var lines []string
+		for _, record := range m.lastDraw {
+			lines = append(lines, renderScoutRecord(record))
+		}
+		b.WriteString(cardStyle.Render(strings.Join(lines, "\n")))
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+func (m ShopModel) renderStore() string {
+	var b strings.Builder
+
+	b.WriteString(RenderHeader(fmt.Sprintf("Week %d Stock", m.gameState.Season.CurrentWeek)))
+	b.WriteString("\n")
+
+	stock := m.gameState.ShopStock()
+	for i, item := range stock {
+		info := fmt.Sprintf("%s %s - %d tokens", item.Supporter.Rarity.String(), item.Supporter.Name, item.Price)
+		if item.Sold {
+			info += " (sold)"
+		} else if item.Supporter.IsOwned && item.Supporter.LimitBreak < models.MaxLimitBreak {
+			info += "\n" + renderCardLevel(*item.Supporter) + " - buying again limit breaks the card"
+		} else if item.Supporter.IsOwned {
+			info += "\n" + renderCardLevel(*item.Supporter) + " - buying again gives training manuals"
+		}
+		b.WriteString(RenderCard(info, m.cursor == i))
+		b.WriteString("\n")
+	}
+
+	size, price := models.ManualsBundle()
+	b.WriteString(RenderCard(fmt.Sprintf("📘 %d Training Manuals - %d tokens", size, price), m.cursor == len(stock)))
+	b.WriteString("\n")
+	b.WriteString(RenderInfo("Stock changes every week"))
+	b.WriteString("\n")
+
+	return b.String()
+}
+
+// historyVisible is how many history entries fit on screen
+const historyVisible = 12
+
+func (m ShopModel) renderHistory() string {
+	history := m.gameState.ScoutHistory
+	if len(history) == 0 {
+		return RenderInfo("No draws or purchases yet.") + "\n"
+	}
+
+	// Newest first, scrolled by the cursor
+	var lines []string
+	for i := len(history) - 1 - m.cursor; i >= 0 && len(lines) < historyVisible; i-- {
+		record := history[i]
+		lines = append(lines, fmt.Sprintf("S%d W%-2d %-6s %s", record.Season, record.Week, record.Source, renderScoutRecord(record)))
+	}
+
+	counts := make(map[models.Rarity]int)
+	for _, record := range history {
+		counts[record.Rarity]++
+	}
+	var summary []string
+	for _, rate := range models.ScoutRates {
+		summary = append(summary, fmt.Sprintf("%s x%d", rate.Rarity.String(), counts[rate.Rarity]))
+	}
+
+	return cardStyle.Render(strings.Join(lines, "\n")) + "\n" +
+		RenderInfo(fmt.Sprintf("%d entries: %s", len(history), strings.Join(summary, " | "))) + "\n"
+}
+
+// renderScoutRecord describes one draw or purchase
+func renderScoutRecord(record models.ScoutRecord) string {
+	line := record.Outcome
+	if record.Featured {
+		line += " ✨"
+	}
+	if record.Pity {
+		line += " (pity)"
+	}
+	return line
+}
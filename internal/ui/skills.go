@@ -0,0 +1,138 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"goderby/internal/models"
+)
+
+type SkillsModel struct {
+	gameState *models.GameState
+	cursor    int
+	message   string
+	isError   bool
+}
+
+func NewSkillsModel(gameState *models.GameState) SkillsModel {
+	return SkillsModel{
+		gameState: gameState,
+	}
+}
+
+func (m SkillsModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m SkillsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q", "esc":
+			return m, func() tea.Msg {
+				return NavigationMsg{State: StableView}
+			}
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case "down", "j":
+			if m.cursor < len(models.AllSkills())-1 {
+				m.cursor++
+			}
+		case "enter", " ":
+			m.toggleSkill()
+		}
+	}
+
+	return m, nil
+}
+
+// toggleSkill equips or unequips the skill under the cursor
+func (m *SkillsModel) toggleSkill() {
+	horse := m.gameState.PlayerHorse
+	if horse == nil {
+		return
+	}
+
+	skill := models.AllSkills()[m.cursor]
+	if !horse.HasSkill(skill.ID) {
+		m.message = fmt.Sprintf("%s hasn't learned %s yet", horse.Name, skill.Name)
+		m.isError = true
+		return
+	}
+
+	if horse.IsSkillEquipped(skill.ID) {
+		horse.UnequipSkill(skill.ID)
+		m.message = fmt.Sprintf("Unequipped %s", skill.Name)
+		m.isError = false
+		return
+	}
+
+	if err := horse.EquipSkill(skill.ID); err != nil {
+		m.message = fmt.Sprintf("Cannot equip %s: %s", skill.Name, err.Error())
+		m.isError = true
+		return
+	}
+	m.message = fmt.Sprintf("Equipped %s", skill.Name)
+	m.isError = false
+}
+
+func (m SkillsModel) View() string {
+	var b strings.Builder
+
+	b.WriteString(RenderTitle("✨ Skills"))
+	b.WriteString("\n\n")
+
+	horse := m.gameState.PlayerHorse
+	if horse == nil {
+		b.WriteString(RenderError("No horse selected! Please scout a horse first."))
+		b.WriteString("\n\n")
+		b.WriteString(RenderHelp("ESC/q to go back"))
+		return lipgloss.NewStyle().Padding(1, 2).Render(b.String())
+	}
+
+	b.WriteString(cardStyle.Render(fmt.Sprintf("🐎 %s | Learned: %d/%d | Slots: %d/%d",
+		horse.Name, len(horse.Skills), len(models.AllSkills()), len(horse.EquippedSkills), models.MaxSkillSlots)))
+	b.WriteString("\n\n")
+
+	for i, skill := range models.AllSkills() {
+		cursor := " "
+		if m.cursor == i {
+			cursor = ">"
+		}
+
+		var info string
+		switch {
+		case horse.IsSkillEquipped(skill.ID):
+			info = fmt.Sprintf("%s %s %s ✓ Equipped\n   %s", cursor, skill.Icon, skill.Name, skill.Description)
+		case horse.HasSkill(skill.ID):
+			info = fmt.Sprintf("%s %s %s\n   %s", cursor, skill.Icon, skill.Name, skill.Description)
+		default:
+			info = fmt.Sprintf("%s 🔒 %s\n   Unlock: %s", cursor, skill.Name, skill.Unlock)
+			if hints := horse.SkillHintProgress(skill.ID); hints > 0 {
+				info += fmt.Sprintf("\n   💡 Hints: %d/%d", hints, models.SkillHintsToLearn)
+			}
+		}
+
+		b.WriteString(RenderCard(info, m.cursor == i))
+		b.WriteString("\n")
+	}
+
+	if m.message != "" {
+		b.WriteString("\n")
+		if m.isError {
+			b.WriteString(RenderError(m.message))
+		} else {
+			b.WriteString(RenderSuccess(m.message))
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(RenderHelp("↑/↓ to navigate, Enter to equip/unequip, ESC/q to go back"))
+
+	return lipgloss.NewStyle().Padding(1, 2).Render(b.String())
+}
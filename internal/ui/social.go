@@ -111,7 +111,12 @@ func RenderShareableSeasonSummary(horse *models.Horse, season models.Season, gam
 	b.WriteString("\n")
 
 	// Calculate season-specific stats
-	seasonRaces := len(season.CompletedRaces)
+	seasonRaces := 0
+	for _, result := range season.RaceResults {
+		if result.HorseID == horse.ID {
+			seasonRaces++
+		}
+	}
 	winRate := 0.0
 	if horse.Races > 0 {
 		winRate = float64(horse.Wins) / float64(horse.Races) * 100
@@ -146,7 +151,7 @@ func RenderShareableSeasonSummary(horse *models.Horse, season models.Season, gam
 
 	for _, day := range season.TrainingDays {
 		if day.IsCompleted && !day.IsRest {
-			switch day.TrainingType {
+			switch day.TrainingType.Stat() {
 			case models.StaminaTraining:
 				staminaTraining++
 			case models.SpeedTraining:
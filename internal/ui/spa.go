@@ -208,7 +208,7 @@ func (m SpaModel) purchaseSpaService() (SpaModel, tea.Cmd) {
 	horse := m.gameState.PlayerHorse
 
 	// Check if player has enough money
-	if horse.Money < service.Cost {
+	if !m.gameState.CanAfford(service.Cost) {
 		m.lastResult = &SpaResult{
 			Success: false,
 			Message: "Not enough money for this service!",
@@ -228,7 +228,14 @@ func (m SpaModel) purchaseSpaService() (SpaModel, tea.Cmd) {
 	}
 
 	// Apply spa treatment
-	horse.Money -= service.Cost
+	if err := m.gameState.Spend(models.SpaExpense, fmt.Sprintf("%s: %s", service.Name, horse.Name), service.Cost, horse.ID); err != nil {
+		m.lastResult = &SpaResult{
+			Success: false,
+			Message: fmt.Sprintf("Treatment failed: %v", err),
+		}
+		m.mode = ViewingSpaResult
+		return m, nil
+	}
 	oldFatigue := horse.Fatigue
 	oldMorale := horse.Morale
 
@@ -274,7 +281,7 @@ func (m SpaModel) View() string {
 		b.WriteString("\n")
 
 		horseInfo := fmt.Sprintf("🐎 %s\n", horse.Name)
-		horseInfo += fmt.Sprintf("💰 Money: $%d\n", horse.Money)
+		horseInfo += fmt.Sprintf("💰 Treasury: $%d\n", m.gameState.Treasury)
 		horseInfo += fmt.Sprintf("😴 Fatigue: %d/100\n", horse.Fatigue)
 		horseInfo += fmt.Sprintf("😊 Morale: %d/100\n", horse.Morale)
 
@@ -317,7 +324,7 @@ func (m SpaModel) View() string {
 				b.WriteString(selectedCardStyle.Render(serviceInfo))
 			} else {
 				// Check affordability
-				if m.gameState.PlayerHorse.Money < service.Cost {
+				if !m.gameState.CanAfford(service.Cost) {
 					dimmedStyle := lipgloss.NewStyle().
 						Border(lipgloss.RoundedBorder()).
 						BorderForeground(lipgloss.Color("#555555")).
@@ -0,0 +1,166 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"goderby/internal/models"
+)
+
+// pastContractsVisible is how many finished contracts the sponsor screen lists
+const pastContractsVisible = 5
+
+// SponsorsModel lists the stable's sponsor contracts and this week's offers for the active horse
+type SponsorsModel struct {
+	gameState *models.GameState
+	cursor    int
+	message   string
+	isError   bool
+}
+
+func NewSponsorsModel(gameState *models.GameState) SponsorsModel {
+	return SponsorsModel{
+		gameState: gameState,
+	}
+}
+
+func (m SponsorsModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m SponsorsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	offers := m.gameState.SponsorOffers()
+	switch keyMsg.String() {
+	case "ctrl+c", "q", "esc":
+		return m, func() tea.Msg {
+			return NavigationMsg{State: MainMenuView}
+		}
+	case "up", "k":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case "down", "j":
+		if m.cursor < len(offers)-1 {
+			m.cursor++
+		}
+	case "enter", " ":
+		if m.cursor >= len(offers) {
+			break
+		}
+		offer := offers[m.cursor]
+		if err := m.gameState.SignContract(offer.ID); err != nil {
+			m.message = err.Error()
+			m.isError = true
+			break
+		}
+		m.message = fmt.Sprintf("🤝 Signed with %s: $%d upfront", offer.Sponsor, offer.Upfront)
+		m.isError = false
+		m.cursor = max(min(m.cursor, len(offers)-2), 0)
+	}
+
+	return m, nil
+}
+
+func (m SponsorsModel) View() string {
+	var b strings.Builder
+
+	b.WriteString(RenderTitle("🤝 Sponsors"))
+	b.WriteString("\n\n")
+
+	b.WriteString(cardStyle.Render(fmt.Sprintf("Treasury: $%d | Season %d - Week %d/%d",
+		m.gameState.Treasury, m.gameState.Season.Number, m.gameState.Season.CurrentWeek, m.gameState.Season.MaxWeeks)))
+	b.WriteString("\n\n")
+
+	b.WriteString(RenderHeader("Active Contracts"))
+	b.WriteString("\n")
+	var active []string
+	for _, contract := range m.gameState.Contracts {
+		if contract.Status == models.ContractActive {
+			active = append(active, renderContract(contract, true))
+		}
+	}
+	if len(active) == 0 {
+		b.WriteString(RenderInfo("No active contracts. Sign an offer below."))
+	} else {
+		b.WriteString(cardStyle.Render(strings.Join(active, "\n\n")))
+	}
+	b.WriteString("\n\n")
+
+	horse := m.gameState.PlayerHorse
+	if horse == nil {
+		b.WriteString(RenderInfo("Scout a horse to attract sponsors."))
+	} else {
+		tier := horse.FanTier()
+		b.WriteString(RenderHeader(fmt.Sprintf("Offers for %s (%s %s)", horse.Name, tier.Icon, tier.Name)))
+		b.WriteString("\n")
+		offers := m.gameState.SponsorOffers()
+		if len(offers) == 0 {
+			b.WriteString(RenderInfo("No offers this week."))
+			b.WriteString("\n")
+		}
+		for i, offer := range offers {
+			b.WriteString(RenderCard(renderContract(offer, false), m.cursor == i))
+			b.WriteString("\n")
+		}
+		b.WriteString(RenderInfo(fmt.Sprintf("Offers change every week and grow with fame. Up to %d contracts per horse; unmet objectives are penalised at season end.",
+			models.MaxSponsorContracts)))
+	}
+	b.WriteString("\n")
+
+	var past []string
+	for i := len(m.gameState.Contracts) - 1; i >= 0 && len(past) < pastContractsVisible; i-- {
+		contract := m.gameState.Contracts[i]
+		if contract.Status == models.ContractActive {
+			continue
+		}
+		icon := "🏅"
+		if contract.Status == models.ContractFailed {
+			icon = "📉"
+		}
+		past = append(past, fmt.Sprintf("%s S%d %s - %s: %s (%s)", icon, contract.Season, contract.HorseName,
+			contract.Sponsor, contract.Objective.String(), contract.Status.String()))
+	}
+	if len(past) > 0 {
+		b.WriteString("\n")
+		b.WriteString(RenderHeader("Past Contracts"))
+		b.WriteString("\n")
+		b.WriteString(cardStyle.Render(strings.Join(past, "\n")))
+		b.WriteString("\n")
+	}
+
+	if m.message != "" {
+		b.WriteString("\n")
+		if m.isError {
+			b.WriteString(RenderError(m.message))
+		} else {
+			b.WriteString(RenderSuccess(m.message))
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(RenderHelp("↑/↓: Navigate | Enter: Sign | ESC: Back"))
+
+	return lipgloss.NewStyle().Padding(1, 2).Render(b.String())
+}
+
+// renderContract describes a contract's objective and pay, with a progress bar once signed
+func renderContract(contract models.SponsorContract, signed bool) string {
+	objective := contract.Objective
+	info := fmt.Sprintf("%s: %s", contract.Sponsor, objective.String())
+	if signed {
+		info = fmt.Sprintf("%s - %s", contract.HorseName, info)
+		info += fmt.Sprintf("\n%s %d/%d milestones",
+			RenderProgressBar(contract.Progress, objective.Milestones(), 15, statBarStyle), contract.Progress, objective.Milestones())
+	}
+	info += fmt.Sprintf("\n$%d upfront | $%d per milestone | $%d bonus | $%d penalty",
+		contract.Upfront, contract.Milestone, contract.Bonus, contract.Penalty)
+	return info
+}
@@ -0,0 +1,206 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"goderby/internal/models"
+)
+
+type StableModel struct {
+	gameState  *models.GameState
+	cursor     int
+	message    string
+	isError    bool
+	viewStart  int // For scrolling
+	maxVisible int // Maximum horses visible at once
+}
+
+func NewStableModel(gameState *models.GameState) StableModel {
+	cursor := 0
+	for i, horse := range gameState.Stable {
+		if horse.ID == gameState.ActiveHorseID {
+			cursor = i
+			break
+		}
+	}
+
+	maxVisible := 3
+	viewStart := 0
+	if cursor >= maxVisible {
+		viewStart = cursor - maxVisible + 1
+	}
+
+	return StableModel{
+		gameState:  gameState,
+		cursor:     cursor,
+		viewStart:  viewStart,
+		maxVisible: maxVisible,
+	}
+}
+
+func (m StableModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m StableModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q", "esc":
+			return m, func() tea.Msg {
+				return NavigationMsg{State: MainMenuView}
+			}
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+				if m.cursor < m.viewStart {
+					m.viewStart = m.cursor
+				}
+			}
+		case "down", "j":
+			if m.cursor < len(m.gameState.Stable)-1 {
+				m.cursor++
+				if m.cursor >= m.viewStart+m.maxVisible {
+					m.viewStart = m.cursor - m.maxVisible + 1
+				}
+			}
+		case "enter", " ":
+			if m.selectCurrentHorse() {
+				m.message = fmt.Sprintf("Now managing %s", m.gameState.PlayerHorse.Name)
+				m.isError = false
+			}
+		case "t":
+			if m.selectCurrentHorse() {
+				return m, func() tea.Msg {
+					return NavigationMsg{State: TrainView}
+				}
+			}
+		case "r":
+			if m.selectCurrentHorse() {
+				return m, func() tea.Msg {
+					return NavigationMsg{State: RaceView}
+				}
+			}
+		case "s":
+			if m.selectCurrentHorse() {
+				return m, func() tea.Msg {
+					return NavigationMsg{State: SpaView}
+				}
+			}
+		case "a":
+			if m.selectCurrentHorse() {
+				return m, func() tea.Msg {
+					return NavigationMsg{State: SkillsView}
+				}
+			}
+		case "u":
+			cost := m.gameState.BarnUpgradeCost()
+			if err := m.gameState.UpgradeBarn(); err != nil {
+				m.message = fmt.Sprintf("Cannot upgrade barn: %s", err.Error())
+				m.isError = true
+			} else {
+				m.message = fmt.Sprintf("Barn upgraded for $%d! Capacity is now %d horses", cost, m.gameState.StableCapacity())
+				m.isError = false
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// selectCurrentHorse makes the horse under the cursor the active horse
+func (m *StableModel) selectCurrentHorse() bool {
+	if m.cursor >= len(m.gameState.Stable) {
+		return false
+	}
+	if err := m.gameState.SetActiveHorse(m.gameState.Stable[m.cursor].ID); err != nil {
+		m.message = err.Error()
+		m.isError = true
+		return false
+	}
+	return true
+}
+
+func (m StableModel) View() string {
+	var b strings.Builder
+
+	b.WriteString(RenderTitle("🏇 Stable"))
+	b.WriteString("\n\n")
+
+	barnInfo := fmt.Sprintf("Barn Level %d/%d | Stalls: %d/%d",
+		max(m.gameState.BarnLevel, 1), models.MaxBarnLevel, len(m.gameState.Stable), m.gameState.StableCapacity())
+	if m.gameState.BarnLevel < models.MaxBarnLevel {
+		barnInfo += fmt.Sprintf(" | Next upgrade: $%d", m.gameState.BarnUpgradeCost())
+	}
+	b.WriteString(cardStyle.Render(barnInfo))
+	b.WriteString("\n\n")
+
+	if len(m.gameState.Stable) == 0 {
+		b.WriteString(RenderWarning("Your stable is empty! Scout a horse to get started."))
+		b.WriteString("\n\n")
+		b.WriteString(RenderHelp("ESC/q to go back"))
+		return lipgloss.NewStyle().Padding(1, 2).Render(b.String())
+	}
+
+	b.WriteString(RenderHeader(fmt.Sprintf("Your Horses - Week %d", m.gameState.Season.CurrentWeek)))
+	b.WriteString("\n\n")
+
+	viewEnd := min(m.viewStart+m.maxVisible, len(m.gameState.Stable))
+	for i := m.viewStart; i < viewEnd; i++ {
+		horse := m.gameState.Stable[i]
+		cursor := " "
+		if m.cursor == i {
+			cursor = ">"
+		}
+
+		active := ""
+		if horse.ID == m.gameState.ActiveHorseID {
+			active = " ★ Active"
+		}
+
+		daysDone := 0
+		for _, day := range m.gameState.Season.GetHorseTrainingDays(horse.ID) {
+			if day.IsCompleted {
+				daysDone++
+			}
+		}
+
+		info := fmt.Sprintf("%s 🐎 %s %s (%s, Age %d)%s", cursor, horse.Name, horse.Sex.Icon(), horse.Breed, horse.Age, active)
+		info += fmt.Sprintf("\n   Rating: %d | Fatigue: %d/100 | Morale: %d/100", horse.GetOverallRating(), horse.Fatigue, horse.Morale)
+		info += fmt.Sprintf("\n   Training: %d/6 days | Wins: %d/%d | Earnings: $%d", daysDone, horse.Wins, horse.Races, horse.Money)
+		info += fmt.Sprintf("\n   Skills: %d learned, %d/%d equipped", len(horse.Skills), len(horse.EquippedSkills), models.MaxSkillSlots)
+
+		b.WriteString(RenderCard(info, m.cursor == i))
+		b.WriteString("\n")
+	}
+
+	if len(m.gameState.Stable) > m.maxVisible {
+		scrollInfo := fmt.Sprintf("Showing %d-%d of %d horses", m.viewStart+1, viewEnd, len(m.gameState.Stable))
+		if m.viewStart > 0 {
+			scrollInfo += " ↑"
+		}
+		if viewEnd < len(m.gameState.Stable) {
+			scrollInfo += " ↓"
+		}
+		b.WriteString(RenderInfo(scrollInfo))
+		b.WriteString("\n")
+	}
+
+	if m.message != "" {
+		b.WriteString("\n")
+		if m.isError {
+			b.WriteString(RenderError(m.message))
+		} else {
+			b.WriteString(RenderSuccess(m.message))
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(RenderHelp("Enter to make active, t: Train, r: Race, s: Spa, a: Skills, u: Upgrade barn, ESC/q to go back"))
+
+	return lipgloss.NewStyle().Padding(1, 2).Render(b.String())
+}
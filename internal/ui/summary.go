@@ -22,6 +22,9 @@ type SummaryModel struct {
 	raceHistoryCursor int
 	raceHistoryStart  int
 	maxRacesVisible   int
+	homeCursor        int
+	retireRole        models.PostRetirementRole
+	homeMessage       string
 }
 
 type SummarySection struct {
@@ -67,6 +70,10 @@ func (m *SummaryModel) Init() tea.Cmd {
 func (m *SummaryModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		if m.mode == RetirementHomes {
+			return m.updateRetirementHomes(msg)
+		}
+
 		switch msg.String() {
 		case "ctrl+c", "q":
 			return m, func() tea.Msg {
@@ -158,6 +165,9 @@ func (m *SummaryModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case "b": // Changed from "h" to avoid conflict with left navigation
 			if m.mode == RetirementCeremony {
 				m.mode = RetirementHomes
+				m.homeCursor = 0
+				m.retireRole = models.ShowHorse
+				m.homeMessage = ""
 				return m, nil
 			}
 		case "s":
@@ -182,6 +192,70 @@ func (m *SummaryModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// updateRetirementHomes handles home selection, purchase and retirement
+func (m *SummaryModel) updateRetirementHomes(msg tea.KeyMsg) (*SummaryModel, tea.Cmd) {
+	homes := m.gameState.GetAvailableRetirementHomes()
+	roles := models.AvailableRoles(m.gameState.PlayerHorse.Sex)
+
+	switch msg.String() {
+	case "ctrl+c", "q":
+		return m, func() tea.Msg {
+			return NavigationMsg{State: MainMenuView}
+		}
+	case "esc":
+		m.mode = RetirementCeremony
+		m.homeMessage = ""
+	case "up", "k":
+		if m.homeCursor > 0 {
+			m.homeCursor--
+		}
+	case "down", "j":
+		if m.homeCursor < len(homes)-1 {
+			m.homeCursor++
+		}
+	case "left", "h", "right", "l":
+		// Cycle through the roles available to this horse
+		current := 0
+		for i, role := range roles {
+			if role == m.retireRole {
+				current = i
+				break
+			}
+		}
+		if msg.String() == "left" || msg.String() == "h" {
+			current = (current - 1 + len(roles)) % len(roles)
+		} else {
+			current = (current + 1) % len(roles)
+		}
+		m.retireRole = roles[current]
+	case "enter", " ":
+		if m.homeCursor >= len(homes) {
+			return m, nil
+		}
+		home := homes[m.homeCursor]
+
+		if !home.IsOwned {
+			if err := m.gameState.PurchaseRetirementHome(home.ID); err != nil {
+				m.homeMessage = err.Error()
+			} else {
+				m.homeMessage = fmt.Sprintf("Purchased %s!", home.Name)
+			}
+			return m, nil
+		}
+
+		if err := m.gameState.RetireHorse(home.ID, m.retireRole); err != nil {
+			m.homeMessage = err.Error()
+			return m, nil
+		}
+
+		return m, func() tea.Msg {
+			return NavigationMsg{State: MainMenuView}
+		}
+	}
+
+	return m, nil
+}
+
 func (m *SummaryModel) View() string {
 	var b strings.Builder
 
@@ -351,7 +425,7 @@ func (m SummaryModel) getSeasonAchievements(season models.Season) string {
 	// Count training days and races
 	trainingDays := 0
 	restDays := 0
-	racesCompleted := len(season.CompletedRaces)
+	racesCompleted := len(season.RaceResults)
 
 	for _, day := range season.TrainingDays {
 		if day.IsCompleted {
@@ -483,7 +557,7 @@ func (m SummaryModel) getTrainingSummary(season models.Season) string {
 
 	for _, day := range season.TrainingDays {
 		if day.IsCompleted && !day.IsRest {
-			switch day.TrainingType {
+			switch day.TrainingType.Stat() {
 			case models.StaminaTraining:
 				staminaTraining++
 			case models.SpeedTraining:
@@ -556,8 +630,10 @@ func (m SummaryModel) generateSeasonGoals(seasonNumber int) string {
 }
 
 func (m *SummaryModel) advanceSeason() (*SummaryModel, tea.Cmd) {
-	// Age the horse
-	m.gameState.PlayerHorse.Age++
+	// Age every horse in the stable
+	for _, horse := range m.gameState.Stable {
+		horse.Age++
+	}
 
 	// Preserve completed races history before creating new season
 	for _, raceID := range m.gameState.Season.CompletedRaces {
@@ -574,22 +650,36 @@ func (m *SummaryModel) advanceSeason() (*SummaryModel, tea.Cmd) {
 		}
 	}
 
+	// Settle the final week of retirement income and upkeep before the season closes
+	m.gameState.AccruePassiveGains()
+	m.gameState.ChargeHomeUpkeep()
+
+	// Sponsors pay out or penalise this season's contracts
+	for _, message := range m.gameState.SettleContracts() {
+		m.gameState.AddNotice(message)
+	}
+
 	// Create new season
 	newSeason := models.NewSeason(m.gameState.Season.Number + 1)
 	m.gameState.Season = newSeason
+	for _, message := range m.gameState.ExpireRetainers() {
+		m.gameState.AddNotice(message)
+	}
 
 	// Reset horse condition
-	horse := m.gameState.PlayerHorse
-	horse.Fatigue = 0
-	horse.Morale = 100
+	for _, horse := range m.gameState.Stable {
+		horse.Fatigue = 0
+		horse.Morale = 100
+	}
+
+	// Horses too old to race go to retirement
+	for _, message := range m.gameState.RetireAgedHorses() {
+		m.gameState.AddNotice(message)
+	}
 
 	// Update game stats
 	m.gameState.GameStats.SeasonsCompleted++
-
-	// Check for retirement
-	if horse.Age >= 10 {
-		horse.IsRetired = true
-	}
+	m.gameState.CheckRetirementHomeUnlocks()
 
 	m.mode = AdvancingSeason
 	m.canAdvance = false
@@ -649,6 +739,20 @@ func (m SummaryModel) renderLifetimeStats(horse *models.Horse) string {
 		stats.WriteString("✓ Expert Performance (150+ rating)\n")
 	}
 
+	// Most recent event decisions
+	var decisions []models.EventRecord
+	for i := len(horse.EventHistory) - 1; i >= 0 && len(decisions) < 3; i-- {
+		if horse.EventHistory[i].Choice != "" {
+			decisions = append(decisions, horse.EventHistory[i])
+		}
+	}
+	if len(decisions) > 0 {
+		stats.WriteString("\n📝 Recent Decisions:\n")
+		for _, record := range decisions {
+			stats.WriteString(fmt.Sprintf("S%d W%d %s: %s\n", record.Season, record.Week, record.EventName, record.Choice))
+		}
+	}
+
 	return stats.String()
 }
 
@@ -729,14 +833,24 @@ func (m SummaryModel) renderRetirementHomesView() string {
 		return lipgloss.NewStyle().Padding(1, 2).Render(b.String())
 	}
 
+	horse := m.gameState.PlayerHorse
+	roleInfo := fmt.Sprintf("Retiring: %s (%s)\nRole: ◀ %s ▶", horse.Name, horse.Sex.String(), m.retireRole.String())
+	b.WriteString(cardStyle.Render(roleInfo))
+	b.WriteString("\n\n")
+
 	b.WriteString(RenderHeader("Available Retirement Homes"))
 	b.WriteString("\n")
 
 	for i, home := range homes {
 		var homeInfo strings.Builder
-		homeInfo.WriteString(fmt.Sprintf("🏠 %s\n", home.Name))
+		cursor := " "
+		if m.homeCursor == i {
+			cursor = ">"
+		}
+		homeInfo.WriteString(fmt.Sprintf("%s 🏠 %s\n", cursor, home.Name))
 		homeInfo.WriteString(fmt.Sprintf("Description: %s\n", home.Description))
-		homeInfo.WriteString(fmt.Sprintf("Capacity: %d horses\n", home.Capacity))
+		homeInfo.WriteString(fmt.Sprintf("Residents: %d/%d horses\n", m.gameState.CountResidents(home.ID), home.Capacity))
+		homeInfo.WriteString(fmt.Sprintf("Upkeep: $%d/week\n", home.CurrentUpkeep()))
 		homeInfo.WriteString(fmt.Sprintf("Income Multiplier: %.1fx\n", home.IncomeMultiplier))
 		homeInfo.WriteString(fmt.Sprintf("Fame Multiplier: %.1fx\n", home.FameMultiplier))
 
@@ -745,7 +859,7 @@ func (m SummaryModel) renderRetirementHomesView() string {
 				homeInfo.WriteString("Status: Owned ✓\n")
 			} else {
 				homeInfo.WriteString(fmt.Sprintf("Cost: $%d\n", home.Cost))
-				if m.gameState.PlayerHorse.Money >= home.Cost {
+				if m.gameState.CanAfford(home.Cost) {
 					homeInfo.WriteString("Status: Available for purchase\n")
 				} else {
 					homeInfo.WriteString("Status: Cannot afford\n")
@@ -755,14 +869,19 @@ func (m SummaryModel) renderRetirementHomesView() string {
 			homeInfo.WriteString("Status: Free\n")
 		}
 
-		b.WriteString(cardStyle.Render(homeInfo.String()))
+		b.WriteString(RenderCard(homeInfo.String(), m.homeCursor == i))
 		if i < len(homes)-1 {
 			b.WriteString("\n")
 		}
 	}
 
+	if m.homeMessage != "" {
+		b.WriteString("\n\n")
+		b.WriteString(RenderWarning(m.homeMessage))
+	}
+
 	b.WriteString("\n\n")
-	b.WriteString(RenderHelp("ESC to go back to retirement ceremony"))
+	b.WriteString(RenderHelp("↑/↓ to choose home, ←/→ to choose role, Enter to buy/retire, ESC to go back to retirement ceremony"))
 
 	return lipgloss.NewStyle().Padding(1, 2).Render(b.String())
 }
@@ -884,7 +1003,6 @@ func (m SummaryModel) renderShareableRetirementCardView() string {
 		Awards:             awards,
 		PassiveIncome:      highlights.TotalPrizeMoney / 100,
 		PassiveFame:        highlights.TotalFanSupport / 50,
-		LastPassiveGain:    time.Now(),
 	}
 
 	shareableCard := RenderShareableRetirementCard(tempRetired)
@@ -913,7 +1031,15 @@ func (m *SummaryModel) buildSections() {
 	progressInfo += fmt.Sprintf("Overall Rating: %d\n", horse.GetOverallRating())
 	progressInfo += fmt.Sprintf("Races: %d | Wins: %d (%.1f%%)\n",
 		horse.Races, horse.Wins, m.getWinPercentage(horse))
-	progressInfo += fmt.Sprintf("Total Fans: %d | Money: $%d", horse.FanSupport, horse.Money)
+	progressInfo += fmt.Sprintf("Total Fans: %d | Earnings: $%d\n", horse.FanSupport, horse.Money)
+	tier := horse.FanTier()
+	progressInfo += fmt.Sprintf("Fan Tier: %s %s", tier.Icon, tier.Name)
+	if next, ok := horse.NextFanTier(); ok {
+		progressInfo += fmt.Sprintf(" (%d more fans for %s)", next.MinFans-horse.FanSupport, next.Name)
+	}
+	if horse.Rival != nil {
+		progressInfo += fmt.Sprintf("\nRival: %s (%d-%d)", horse.Rival.Name, horse.Rival.Wins, horse.Rival.Losses)
+	}
 
 	m.sections = append(m.sections, SummarySection{
 		Title:   fmt.Sprintf("%s's Progress", horse.Name),
@@ -961,6 +1087,13 @@ func (m *SummaryModel) buildSections() {
 		Icon:    "📈",
 	})
 
+	// Fan History Section
+	m.sections = append(m.sections, SummarySection{
+		Title:   "Fan History",
+		Content: renderFanHistory(horse),
+		Icon:    "📣",
+	})
+
 	// Social Sharing Section
 	sharingContent := "Create shareable cards to show off your horse's achievements!\n\n"
 	sharingContent += "• Create Shareable Profile Card (p)\n"
@@ -971,6 +1104,22 @@ func (m *SummaryModel) buildSections() {
 		Icon:    "📤",
 	})
 
+	// Retirement Income Section
+	if len(m.gameState.RetiredHorses) > 0 {
+		monthlyIncome, monthlyFame := m.gameState.GetMonthlyPassiveGains()
+		incomeContent := fmt.Sprintf("Earned this season: $%d | %d fans\n", season.PassiveIncome, season.PassiveFame)
+		incomeContent += fmt.Sprintf("Current rate: $%d and %d fans every %d weeks\n\n", monthlyIncome, monthlyFame, models.WeeksPerMonth)
+		for _, retired := range m.gameState.RetiredHorses {
+			incomeContent += fmt.Sprintf("• %s (%s): $%d/month, %d fans/month\n",
+				retired.Horse.Name, retired.RetirementHome.Name, retired.PassiveIncome, retired.PassiveFame)
+		}
+		m.sections = append(m.sections, SummarySection{
+			Title:   "Retirement Income",
+			Content: strings.TrimRight(incomeContent, "\n"),
+			Icon:    "🏠",
+		})
+	}
+
 	// Retired Horses Gallery (if applicable)
 	if len(m.gameState.RetiredHorses) > 0 {
 		galleryContent := fmt.Sprintf("View your retired horses gallery with %d horses.\n\n", len(m.gameState.RetiredHorses))
@@ -983,6 +1132,47 @@ func (m *SummaryModel) buildSections() {
 	}
 }
 
+// renderFanHistory charts the horse's fan count week by week
+func renderFanHistory(horse *models.Horse) string {
+	const chartHeight = 8
+	const chartWidth = 48
+
+	history := horse.FanHistory
+	if len(history) == 0 {
+		return "No fans recorded yet. Race, and hold meet-and-greets, to build a following!"
+	}
+
+	start := max(len(history)-chartWidth, 0)
+	points := history[start:]
+
+	high := 0
+	for _, point := range points {
+		high = max(high, point.Fans)
+	}
+	span := max(high, 1)
+
+	var chart strings.Builder
+	for row := chartHeight; row >= 1; row-- {
+		chart.WriteString(fmt.Sprintf("%7d │", span*row/chartHeight))
+		for _, point := range points {
+			if point.Fans*chartHeight/span >= row {
+				chart.WriteString("█")
+			} else {
+				chart.WriteString(" ")
+			}
+		}
+		chart.WriteString("\n")
+	}
+	chart.WriteString(fmt.Sprintf("%7d └%s\n", 0, strings.Repeat("─", len(points))))
+	chart.WriteString(fmt.Sprintf("%9sS%d W%d → S%d W%d", "",
+		points[0].Season, points[0].Week, points[len(points)-1].Season, points[len(points)-1].Week))
+	if horse.LosingStreak > models.LosingStreakGrace {
+		chart.WriteString(fmt.Sprintf("\n💔 %d losses in a row - fans are drifting away", horse.LosingStreak))
+	}
+
+	return chart.String()
+}
+
 func (m *SummaryModel) renderActionButtons() string {
 	var b strings.Builder
 	horse := m.gameState.PlayerHorse
@@ -1072,13 +1262,8 @@ func (m *SummaryModel) getRaceHistoryScrollable(season models.Season) string {
 	if m.raceHistoryCursor < len(season.CompletedRaces) {
 		raceID := season.CompletedRaces[m.raceHistoryCursor]
 
-		// Check if we have a stored result for this race
-		var raceResult *models.CompletedRaceResult
-		if season.RaceResults != nil {
-			if result, ok := season.RaceResults[raceID]; ok {
-				raceResult = &result
-			}
-		}
+		// Stored results for each stable horse that ran this race
+		raceResults := season.ResultsFor(raceID)
 
 		// Find race details
 		var raceDetails *models.Race
@@ -1111,8 +1296,8 @@ func (m *SummaryModel) getRaceHistoryScrollable(season models.Season) string {
 			history.WriteString(fmt.Sprintf("   📅 Date: %s\n", raceDetails.Date.Format("Jan 2, 2006")))
 		}
 
-		// Show actual race result if available
-		if raceResult != nil {
+		// Show actual race results if available
+		for _, raceResult := range raceResults {
 			// Show position
 			positionIcon := "🏁"
 			if raceResult.Position == 1 {
@@ -1122,7 +1307,13 @@ func (m *SummaryModel) getRaceHistoryScrollable(season models.Season) string {
 			} else if raceResult.Position == 3 {
 				positionIcon = "🥉"
 			}
+			if raceResult.HorseName != "" {
+				history.WriteString(fmt.Sprintf("   🐎 Runner: %s\n", raceResult.HorseName))
+			}
 			history.WriteString(fmt.Sprintf("   %s Finished: %d of %d\n", positionIcon, raceResult.Position, raceResult.TotalEntrants))
+			if raceResult.WeightCarried > 0 {
+				history.WriteString(fmt.Sprintf("   ⚖️ Carried: %dkg\n", raceResult.WeightCarried))
+			}
 
 			// Show actual earnings and fans gained
 			if raceResult.PrizeMoney > 0 {
@@ -1131,7 +1322,8 @@ func (m *SummaryModel) getRaceHistoryScrollable(season models.Season) string {
 			if raceResult.FansGained > 0 {
 				history.WriteString(fmt.Sprintf("   👥 Fans Gained: %d\n", raceResult.FansGained))
 			}
-		} else {
+		}
+		if len(raceResults) == 0 {
 			// Show estimated performance if no result stored
 			performance := m.estimateRacePerformance(raceDetails)
 			performanceIcon := m.getPerformanceIcon(performance)
@@ -1176,7 +1368,7 @@ func (m *SummaryModel) getRaceHistoryScrollable(season models.Season) string {
 		uniqueRaces := m.getUniqueRaces()
 
 		history.WriteString("📊 Season Racing Summary:\n")
-		history.WriteString(fmt.Sprintf("• Total Race Entries: %d\n", len(season.CompletedRaces)))
+		history.WriteString(fmt.Sprintf("• Total Race Entries: %d\n", len(season.RaceResults)))
 		history.WriteString(fmt.Sprintf("• Unique Races Competed: %d\n", len(uniqueRaces)))
 		history.WriteString(fmt.Sprintf("• Career Record: %d wins in %d races (%.1f%%)", horse.Wins, horse.Races, winRate))
 	}
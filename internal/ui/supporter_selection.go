@@ -9,6 +9,15 @@ import (
 	"goderby/internal/models"
 )
 
+// DeckMode is what the supporter selection screen is doing
+type DeckMode int
+
+const (
+	EditingDeck DeckMode = iota
+	NamingDeck
+	BrowsingDecks
+)
+
 type SupporterSelectionModel struct {
 	gameState           *models.GameState
 	cursor              int
@@ -16,13 +25,26 @@ type SupporterSelectionModel struct {
 	confirmed           bool
 	viewStart           int // For scrolling
 	maxVisible          int // Maximum supporters visible at once
+	mode                DeckMode
+	deckName            string // Name being typed for a new deck preset
+	presetCursor        int
+	message             string
+	isError             bool
 }
 
 func NewSupporterSelectionModel(gameState *models.GameState) SupporterSelectionModel {
+	// Only cards that can go in the deck are listed: owned cards and the starter commons
+	var available []models.Supporter
+	for _, supporter := range gameState.Supporters {
+		if supporter.IsOwned || supporter.Rarity == models.Common {
+			available = append(available, supporter)
+		}
+	}
+
 	return SupporterSelectionModel{
 		gameState:           gameState,
 		cursor:              0,
-		availableSupporters: gameState.Supporters,
+		availableSupporters: available,
 		confirmed:           false,
 		viewStart:           0,
 		maxVisible:          3, // Show 3 supporters at a time
@@ -36,6 +58,13 @@ func (m SupporterSelectionModel) Init() tea.Cmd {
 func (m SupporterSelectionModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		switch m.mode {
+		case NamingDeck:
+			return m.updateDeckName(msg), nil
+		case BrowsingDecks:
+			return m.updateDeckPresets(msg), nil
+		}
+
 		switch msg.String() {
 		case "ctrl+c", "q":
 			return m, tea.Quit
@@ -45,9 +74,27 @@ func (m SupporterSelectionModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					return NavigationMsg{State: MainMenuView}
 				}
 			}
+			// Decks are edited from the supporters screen once a horse is in training
+			if m.gameState.PlayerHorse != nil {
+				return m, func() tea.Msg {
+					return NavigationMsg{State: SupportersView}
+				}
+			}
 			return m, func() tea.Msg {
 				return NavigationMsg{State: ScoutView}
 			}
+		case "s":
+			if !m.confirmed && len(m.gameState.ActiveSupporters) > 0 {
+				m.deckName = m.gameState.ActiveDeckPreset()
+				m.mode = NamingDeck
+				m.message = ""
+			}
+		case "p":
+			if !m.confirmed {
+				m.presetCursor = 0
+				m.mode = BrowsingDecks
+				m.message = ""
+			}
 		case "up", "k":
 			if m.cursor > 0 {
 				m.cursor--
@@ -104,6 +151,82 @@ func (m SupporterSelectionModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// updateDeckName handles typing a name for saving the deck as a preset
+func (m SupporterSelectionModel) updateDeckName(msg tea.KeyMsg) SupporterSelectionModel {
+	switch msg.Type {
+	case tea.KeyEsc, tea.KeyCtrlC:
+		m.mode = EditingDeck
+	case tea.KeyBackspace:
+		if len(m.deckName) > 0 {
+			runes := []rune(m.deckName)
+			m.deckName = string(runes[:len(runes)-1])
+		}
+	case tea.KeyEnter:
+		if err := m.gameState.SaveDeckPreset(m.deckName); err != nil {
+			m.message = fmt.Sprintf("Cannot save deck: %s", err.Error())
+			m.isError = true
+		} else {
+			m.message = fmt.Sprintf("Saved deck %s", strings.TrimSpace(m.deckName))
+			m.isError = false
+		}
+		m.mode = EditingDeck
+	case tea.KeySpace, tea.KeyRunes:
+		if len([]rune(m.deckName)) < 20 {
+			m.deckName += string(msg.Runes)
+		}
+	}
+
+	return m
+}
+
+// updateDeckPresets handles loading and deleting saved deck presets
+func (m SupporterSelectionModel) updateDeckPresets(msg tea.KeyMsg) SupporterSelectionModel {
+	presets := m.gameState.DeckPresets
+
+	switch msg.String() {
+	case "esc", "p":
+		m.mode = EditingDeck
+	case "up", "k":
+		if m.presetCursor > 0 {
+			m.presetCursor--
+		}
+	case "down", "j":
+		if m.presetCursor < len(presets)-1 {
+			m.presetCursor++
+		}
+	case "enter", " ":
+		if m.presetCursor >= len(presets) {
+			break
+		}
+		name := presets[m.presetCursor].Name
+		if err := m.gameState.LoadDeckPreset(name); err != nil {
+			m.message = err.Error()
+			m.isError = true
+			break
+		}
+		m.message = fmt.Sprintf("Loaded deck %s", name)
+		m.isError = false
+		m.mode = EditingDeck
+	case "x":
+		if m.presetCursor >= len(presets) {
+			break
+		}
+		name := presets[m.presetCursor].Name
+		if err := m.gameState.DeleteDeckPreset(name); err != nil {
+			m.message = err.Error()
+			m.isError = true
+			break
+		}
+		m.message = fmt.Sprintf("Deleted deck %s", name)
+		m.isError = false
+		if m.presetCursor > 0 && m.presetCursor >= len(m.gameState.DeckPresets) {
+			m.presetCursor--
+		}
+	}
+
+	return m
+}
+
 func (m SupporterSelectionModel) View() string {
 	var b strings.Builder
 
@@ -138,7 +261,16 @@ func (m SupporterSelectionModel) View() string {
 	b.WriteString(RenderHeader(fmt.Sprintf("Choose up to 4 supporters (%d/4 selected)", activeCount)))
 	b.WriteString("\n")
 	b.WriteString(RenderInfo("These supporters will provide training bonuses throughout the season"))
-	b.WriteString("\n\n")
+	b.WriteString("\n")
+	if mentors := m.gameState.GetMentorSupporters(); len(mentors) > 0 {
+		var names []string
+		for _, mentor := range mentors {
+			names = append(names, mentor.Name)
+		}
+		b.WriteString(RenderInfo("🎓 Always active: " + strings.Join(names, ", ")))
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
 
 	if len(m.availableSupporters) == 0 {
 		b.WriteString(RenderWarning("No supporters available!"))
@@ -167,7 +299,8 @@ func (m SupporterSelectionModel) View() string {
 			selectedIcon = " ✓"
 		}
 
-		supporterInfo := fmt.Sprintf("%s %s %s%s", cursor, supporter.Rarity.String(), supporter.Name, selectedIcon)
+		supporterInfo := fmt.Sprintf("%s %s %s%s  %s", cursor, supporter.Rarity.String(), supporter.Name, selectedIcon,
+			renderCardLevel(supporter))
 		supporterInfo += "\n"
 		supporterInfo += fmt.Sprintf("   %s", supporter.Description)
 
@@ -177,11 +310,12 @@ func (m SupporterSelectionModel) View() string {
 			var bonuses []string
 			for trainingType, bonus := range supporter.TrainingBonus {
 				if bonus > 0 {
-					bonuses = append(bonuses, fmt.Sprintf("%s +%d", trainingType.String(), bonus))
+					bonuses = append(bonuses, fmt.Sprintf("%s +%d", trainingType.String(), supporter.EffectiveBonus(trainingType)))
 				}
 			}
 			supporterInfo += strings.Join(bonuses, ", ")
 		}
+		supporterInfo += "\n   " + renderBond(supporter)
 
 		// Style based on selection and cursor
 		style := lipgloss.NewStyle().
@@ -222,11 +356,41 @@ func (m SupporterSelectionModel) View() string {
 		b.WriteString(RenderInfo(scrollInfo))
 	}
 
+	b.WriteString("\n\n")
+	b.WriteString(m.renderDeckSummary())
+
+	switch m.mode {
+	case NamingDeck:
+		b.WriteString("\n")
+		b.WriteString(RenderCard(fmt.Sprintf("Deck name: %s█", m.deckName), true))
+		b.WriteString("\n")
+		b.WriteString(RenderHelp("Type a name | Enter: Save | ESC: Cancel"))
+		return lipgloss.NewStyle().Padding(1, 2).Render(b.String())
+	case BrowsingDecks:
+		b.WriteString("\n")
+		b.WriteString(m.renderDeckPresets())
+		b.WriteString("\n")
+		b.WriteString(RenderHelp("↑/↓: Navigate | Enter: Load deck | X: Delete deck | ESC: Back"))
+		return lipgloss.NewStyle().Padding(1, 2).Render(b.String())
+	}
+
+	if m.message != "" {
+		b.WriteString("\n")
+		if m.isError {
+			b.WriteString(RenderError(m.message))
+		} else {
+			b.WriteString(RenderSuccess(m.message))
+		}
+		b.WriteString("\n")
+	}
+
 	// Instructions
 	b.WriteString("\n")
 	b.WriteString(RenderHelp("Controls:"))
 	b.WriteString("\n")
 	b.WriteString(RenderHelp("↑/↓: Navigate | Enter/Space: Select/Deselect | C: Confirm selection | ESC: Back"))
+	b.WriteString("\n")
+	b.WriteString(RenderHelp("S: Save deck as preset | P: Load a saved deck"))
 
 	if activeCount > 0 {
 		b.WriteString("\n")
@@ -236,6 +400,66 @@ func (m SupporterSelectionModel) View() string {
 	return lipgloss.NewStyle().Padding(1, 2).Render(b.String())
 }
 
+// renderDeckSummary shows the deck's total bonus for each training type and the synergies it earns
+func (m SupporterSelectionModel) renderDeckSummary() string {
+	var b strings.Builder
+
+	title := "Deck Bonuses"
+	if name := m.gameState.ActiveDeckPreset(); name != "" {
+		title += " - " + name
+	}
+	b.WriteString(RenderHeader(title))
+	b.WriteString("\n")
+
+	supporters := m.gameState.GetTrainingSupporters()
+	var totals []string
+	for _, trainingType := range m.gameState.AvailableTrainingTypes() {
+		totals = append(totals, fmt.Sprintf("%s +%d", trainingType.String(), models.CalculateSupporterBonus(supporters, trainingType)))
+	}
+	lines := []string{strings.Join(totals, " | ")}
+
+	synergies := models.DeckSynergies(supporters)
+	if len(synergies) == 0 {
+		lines = append(lines, "No synergies: pair specialists or collect one card of every rarity")
+	}
+	for _, synergy := range synergies {
+		var bonuses []string
+		for _, trainingType := range m.gameState.AvailableTrainingTypes() {
+			if bonus := synergy.Bonus[trainingType]; bonus > 0 {
+				bonuses = append(bonuses, fmt.Sprintf("%s +%d", trainingType.String(), bonus))
+			}
+		}
+		lines = append(lines, fmt.Sprintf("✨ %s (%s): %s", synergy.Name, synergy.Description, strings.Join(bonuses, ", ")))
+	}
+
+	b.WriteString(cardStyle.Render(strings.Join(lines, "\n")))
+	b.WriteString("\n")
+	return b.String()
+}
+
+// renderDeckPresets lists the saved deck presets
+func (m SupporterSelectionModel) renderDeckPresets() string {
+	presets := m.gameState.DeckPresets
+	if len(presets) == 0 {
+		return RenderInfo("No saved decks yet. Press S on the deck screen to save one.") + "\n"
+	}
+
+	var b strings.Builder
+	b.WriteString(RenderHeader(fmt.Sprintf("Saved Decks (%d/%d)", len(presets), models.MaxDeckPresets)))
+	b.WriteString("\n")
+	for i, preset := range presets {
+		var names []string
+		for _, supporterID := range preset.SupporterIDs {
+			if supporter := m.gameState.GetSupporter(supporterID); supporter != nil {
+				names = append(names, supporter.Name)
+			}
+		}
+		b.WriteString(RenderCard(fmt.Sprintf("%s\n%s", preset.Name, strings.Join(names, ", ")), m.presetCursor == i))
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
 type SupportersSelectedMsg struct {
 	Supporters []models.Supporter
 }
@@ -12,9 +12,11 @@ import (
 type SupportersModel struct {
 	gameState    *models.GameState
 	cursor       int
-	selectedPage int // 0 = owned, 1 = all available
+	selectedPage int // 0 = owned, 1 = all available, 2 = mentors
 	viewStart    int // First visible supporter
 	maxVisible   int // Maximum visible supporters
+	message      string
+	isError      bool
 }
 
 func NewSupportersModel(gameState *models.GameState) SupportersModel {
@@ -42,9 +44,32 @@ func (m SupportersModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return NavigationMsg{State: MainMenuView}
 			}
 		case "tab":
-			m.selectedPage = (m.selectedPage + 1) % 2
+			m.selectedPage = (m.selectedPage + 1) % 3
 			m.cursor = 0
 			m.viewStart = 0
+			m.message = ""
+		case "d":
+			if m.gameState.PlayerHorse == nil {
+				m.message = "Scout a horse before building a deck"
+				m.isError = true
+				break
+			}
+			return m, func() tea.Msg {
+				return NavigationMsg{State: SupporterSelectionView}
+			}
+		case "u":
+			owned := m.ownedSupporters()
+			if m.selectedPage != 0 || m.cursor >= len(owned) {
+				break
+			}
+			supporter := owned[m.cursor]
+			if err := m.gameState.LevelUpSupporter(supporter.ID); err != nil {
+				m.message = err.Error()
+				m.isError = true
+				break
+			}
+			m.message = fmt.Sprintf("%s reached level %d!", supporter.Name, m.gameState.GetSupporter(supporter.ID).CardLevel())
+			m.isError = false
 		case "up", "k":
 			if m.cursor > 0 {
 				m.cursor--
@@ -68,16 +93,17 @@ func (m SupportersModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// ownedSupporters returns the cards shown on the Owned tab
+func (m SupportersModel) ownedSupporters() []models.Supporter {
+	return m.gameState.GetOwnedSupporters()
+}
+
 func (m SupportersModel) getMaxItems() int {
 	if m.selectedPage == 0 {
-		// Count owned supporters
-		count := 0
-		for _, supporter := range m.gameState.Supporters {
-			if supporter.IsOwned {
-				count++
-			}
-		}
-		return count
+		return len(m.ownedSupporters())
+	}
+	if m.selectedPage == 2 {
+		return len(m.gameState.GetMentorSupporters())
 	}
 	// All supporters
 	return len(m.gameState.Supporters)
@@ -102,31 +128,42 @@ func (m SupportersModel) View() string {
 		Background(lipgloss.Color("#7ED321")).
 		Foreground(lipgloss.Color("#FFFFFF"))
 
-	var ownedTab, allTab string
-	if m.selectedPage == 0 {
+	ownedTab := tabStyle.Render("Owned")
+	allTab := tabStyle.Render("All")
+	mentorsTab := tabStyle.Render("Mentors")
+	switch m.selectedPage {
+	case 0:
 		ownedTab = activeTabStyle.Render("Owned")
-		allTab = tabStyle.Render("All")
-	} else {
-		ownedTab = tabStyle.Render("Owned")
+	case 1:
 		allTab = activeTabStyle.Render("All")
+	case 2:
+		mentorsTab = activeTabStyle.Render("Mentors")
 	}
 
-	b.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, ownedTab, "  ", allTab))
+	b.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, ownedTab, "  ", allTab, "  ", mentorsTab))
+	b.WriteString("\n\n")
+	b.WriteString(RenderInfo(fmt.Sprintf("📘 Training Manuals: %d", m.gameState.TrainingManuals)))
 	b.WriteString("\n\n")
 
 	// Filter supporters based on current page
 	var displaySupporter []models.Supporter
 	if m.selectedPage == 0 {
 		// Show only owned supporters
-		for _, supporter := range m.gameState.Supporters {
-			if supporter.IsOwned {
-				displaySupporter = append(displaySupporter, supporter)
-			}
-		}
+		displaySupporter = m.ownedSupporters()
 		if len(displaySupporter) == 0 {
 			b.WriteString(RenderInfo("No supporters owned yet."))
 			b.WriteString("\n\n")
 		}
+	} else if m.selectedPage == 2 {
+		// Show retired horses mentoring the next generation
+		displaySupporter = m.gameState.GetMentorSupporters()
+		if len(displaySupporter) == 0 {
+			b.WriteString(RenderInfo("No training mentors yet. Retire a horse with the Training Mentor role."))
+			b.WriteString("\n\n")
+		} else {
+			b.WriteString(RenderInfo("Mentors are always active and don't count toward the 4 supporter limit"))
+			b.WriteString("\n\n")
+		}
 	} else {
 		// Show all supporters
 		displaySupporter = m.gameState.Supporters
@@ -152,6 +189,9 @@ func (m SupportersModel) View() string {
 		content := fmt.Sprintf("%s %s", supporter.Rarity.String(), supporter.Name)
 		if supporter.IsOwned {
 			content += " ✓"
+			if m.selectedPage != 2 {
+				content += "  " + renderCardLevel(supporter)
+			}
 		}
 		content += "\n"
 		content += supporter.Description + "\n"
@@ -162,12 +202,17 @@ func (m SupportersModel) View() string {
 			var bonuses []string
 			for trainingType, bonus := range supporter.TrainingBonus {
 				if bonus > 0 {
-					bonuses = append(bonuses, fmt.Sprintf("%s +%d", trainingType.String(), bonus))
+					bonuses = append(bonuses, fmt.Sprintf("%s +%d", trainingType.String(), supporter.EffectiveBonus(trainingType)))
 				}
 			}
 			content += strings.Join(bonuses, ", ")
 		}
 
+		if supporter.IsOwned && m.selectedPage != 2 {
+			content += "\n" + renderBond(supporter)
+			content += "\n" + renderLevelUpCost(supporter)
+		}
+
 		rarityColor := lipgloss.Color(supporter.Rarity.Color())
 		styledContent := style.Foreground(rarityColor).Render(content)
 		b.WriteString(styledContent)
@@ -188,11 +233,55 @@ func (m SupportersModel) View() string {
 		b.WriteString("\n")
 	}
 
+	if m.message != "" {
+		b.WriteString("\n")
+		if m.isError {
+			b.WriteString(RenderError(m.message))
+		} else {
+			b.WriteString(RenderSuccess(m.message))
+		}
+		b.WriteString("\n")
+	}
+
 	// Instructions
 	b.WriteString("\n")
 	b.WriteString(RenderHelp("Controls:"))
 	b.WriteString("\n")
-	b.WriteString(RenderHelp("↑/↓: Navigate | Tab: Switch tabs | Esc: Back to menu"))
+	if m.selectedPage == 0 {
+		b.WriteString(RenderHelp("↑/↓: Navigate | u: Level up | d: Edit deck | Tab: Switch tabs | Esc: Back to menu"))
+	} else {
+		b.WriteString(RenderHelp("↑/↓: Navigate | d: Edit deck | Tab: Switch tabs | Esc: Back to menu"))
+	}
 
 	return b.String()
 }
+
+// renderCardLevel shows a card's level against its cap and its limit breaks as filled diamonds
+func renderCardLevel(supporter models.Supporter) string {
+	breaks := strings.Repeat("◆", supporter.LimitBreak) + strings.Repeat("◇", models.MaxLimitBreak-supporter.LimitBreak)
+	return fmt.Sprintf("Lv %d/%d %s", supporter.CardLevel(), supporter.LevelCap(), breaks)
+}
+
+// renderLevelUpCost shows what the next card level costs, or why the card can't level
+func renderLevelUpCost(supporter models.Supporter) string {
+	switch {
+	case supporter.CardLevel() < supporter.LevelCap():
+		return fmt.Sprintf("Next level: %d training manuals", supporter.LevelUpCost())
+	case supporter.LimitBreak < models.MaxLimitBreak:
+		return "Level cap reached - limit break with a duplicate"
+	default:
+		return "Max level"
+	}
+}
+
+// renderBond shows a supporter's bond meter and level
+func renderBond(supporter models.Supporter) string {
+	bond := fmt.Sprintf("Bond: %s %d/%d %s", RenderProgressBar(supporter.Bond, models.MaxBond, 15, statBarStyle),
+		supporter.Bond, models.MaxBond, supporter.BondLevelName())
+	if supporter.IsBestFriend() {
+		bond += " 🤝"
+	} else {
+		bond += fmt.Sprintf(" (next level at %d)", supporter.NextBondThreshold())
+	}
+	return bond
+}
@@ -10,13 +10,20 @@ import (
 )
 
 type TrainModel struct {
-	gameState     *models.GameState
-	selectedDay   int
-	selectedType  models.TrainingType
-	trainingTypes []models.TrainingType
-	mode          TrainingMode
-	weekCompleted bool
-	lastResult    *models.TrainingResult
+	gameState      *models.GameState
+	selectedDay    int
+	selectedType   models.TrainingType
+	trainingTypes  []models.TrainingType
+	mode           TrainingMode
+	weekCompleted  bool
+	lastResult     *models.TrainingResult
+	eventCursor    int // Selected choice while deciding on a training event
+	planCursor     int
+	planThrough    int    // Last week the chosen plan is scheduled for
+	planName       string // Name being typed for a new plan template
+	facilityCursor int
+	message        string
+	isError        bool
 }
 
 type TrainingMode int
@@ -26,19 +33,18 @@ const (
 	SelectingType
 	Confirming
 	ViewingTrainingResult
+	ChoosingEvent
+	ChoosingPlan
+	NamingPlan
+	ManagingFacilities
 )
 
 func NewTrainModel(gameState *models.GameState) TrainModel {
 	return TrainModel{
-		gameState:    gameState,
-		selectedDay:  0,
-		selectedType: models.StaminaTraining,
-		trainingTypes: []models.TrainingType{
-			models.StaminaTraining,
-			models.SpeedTraining,
-			models.TechniqueTraining,
-			models.MentalTraining,
-		},
+		gameState:     gameState,
+		selectedDay:   0,
+		selectedType:  models.StaminaTraining,
+		trainingTypes: gameState.AvailableTrainingTypes(),
 		mode:          SelectingDay,
 		weekCompleted: false,
 	}
@@ -51,6 +57,19 @@ func (m TrainModel) Init() tea.Cmd {
 func (m TrainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		// Training events must be decided before anything else
+		if m.mode == ChoosingEvent {
+			return m.updateEventChoice(msg)
+		}
+		switch m.mode {
+		case ChoosingPlan:
+			return m.updatePlanChoice(msg)
+		case NamingPlan:
+			return m.updatePlanName(msg), nil
+		case ManagingFacilities:
+			return m.updateFacilities(msg), nil
+		}
+
 		switch msg.String() {
 		case "ctrl+c", "q":
 			return m, func() tea.Msg {
@@ -71,7 +90,7 @@ func (m TrainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.selectedDay--
 				}
 			case SelectingType:
-				idx := int(m.selectedType)
+				idx := m.selectedTypeIndex()
 				if idx > 0 {
 					m.selectedType = m.trainingTypes[idx-1]
 				}
@@ -83,7 +102,7 @@ func (m TrainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.selectedDay++
 				}
 			case SelectingType:
-				idx := int(m.selectedType)
+				idx := m.selectedTypeIndex()
 				if idx < len(m.trainingTypes)-1 {
 					m.selectedType = m.trainingTypes[idx+1]
 				}
@@ -100,7 +119,8 @@ func (m TrainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m.performTraining()
 			case ViewingTrainingResult:
 				m.mode = SelectingDay
-				if m.isWeekComplete() {
+				// Wait for 'n' when other horses in the stable still have days to schedule
+				if m.isWeekComplete() && len(m.pendingStableHorses()) == 0 {
 					return m, func() tea.Msg {
 						return WeekCompleteMsg{}
 					}
@@ -110,6 +130,10 @@ func (m TrainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if m.mode == SelectingDay && m.canTrainToday() {
 				return m.performRest()
 			}
+		case "m":
+			if m.mode == SelectingDay && m.canTrainToday() {
+				return m.performMeetAndGreet()
+			}
 		case "n":
 			if m.mode == SelectingDay && m.isWeekComplete() {
 				return m, func() tea.Msg {
@@ -120,6 +144,43 @@ func (m TrainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			if m.mode == SelectingDay && m.gameState.PlayerHorse.AreAllStatsMaxed() {
 				return m.performDoping()
 			}
+		case "p":
+			if m.mode == SelectingDay {
+				m.mode = ChoosingPlan
+				m.planCursor = 0
+				m.planThrough = m.gameState.Season.CurrentWeek
+				m.message = ""
+			}
+		case "a":
+			if m.mode == SelectingDay && !m.isWeekComplete() {
+				horse := m.gameState.PlayerHorse
+				if plan := m.gameState.GetScheduledPlan(horse.ID, m.gameState.Season.CurrentWeek); plan != nil {
+					return m.followPlan(*plan)
+				}
+			}
+		case "s":
+			if m.mode == SelectingDay {
+				m.mode = NamingPlan
+				m.planName = ""
+				m.message = ""
+			}
+		case "v":
+			if m.mode == SelectingDay {
+				name, err := m.gameState.NextDeckPreset()
+				if err != nil {
+					m.message = err.Error()
+					m.isError = true
+					break
+				}
+				m.message = fmt.Sprintf("Switched to deck %s", name)
+				m.isError = false
+			}
+		case "f":
+			if m.mode == SelectingDay {
+				m.mode = ManagingFacilities
+				m.facilityCursor = 0
+				m.message = ""
+			}
 		}
 	}
 
@@ -141,6 +202,14 @@ func (m TrainModel) View() string {
 	horse := m.gameState.PlayerHorse
 
 	switch m.mode {
+	case ChoosingEvent:
+		return m.renderEventView(horse)
+	case ChoosingPlan:
+		return m.renderPlanView(horse)
+	case NamingPlan:
+		return m.renderPlanNameView()
+	case ManagingFacilities:
+		return m.renderFacilitiesView()
 	case ViewingTrainingResult:
 		return m.renderResultView(horse)
 	case Confirming:
@@ -162,11 +231,52 @@ func (m TrainModel) renderCalendarView(horse *models.Horse) string {
 	b.WriteString(RenderHeader(fmt.Sprintf("%s - Week %d", horse.Name, m.gameState.Season.CurrentWeek)))
 	b.WriteString("\n")
 
-	statusInfo := fmt.Sprintf("Overall Rating: %d | Fatigue: %d/100 | Morale: %d/100 | Money: $%d",
-		horse.GetOverallRating(), horse.Fatigue, horse.Morale, horse.Money)
+	statusInfo := fmt.Sprintf("Overall Rating: %d | Fatigue: %d/100 | Morale: %d/100 | Treasury: $%d",
+		horse.GetOverallRating(), horse.Fatigue, horse.Morale, m.gameState.Treasury)
 	b.WriteString(cardStyle.Render(statusInfo))
 	b.WriteString("\n\n")
 
+	// Supporter deck
+	if len(m.gameState.ActiveSupporters) > 0 {
+		deckInfo := fmt.Sprintf("🃏 Deck: %d supporters", len(m.gameState.ActiveSupporters))
+		if name := m.gameState.ActiveDeckPreset(); name != "" {
+			deckInfo = fmt.Sprintf("🃏 Deck: %s", name)
+		}
+		var synergies []string
+		for _, synergy := range models.DeckSynergies(m.gameState.GetActiveSupporters()) {
+			synergies = append(synergies, synergy.Name)
+		}
+		if len(synergies) > 0 {
+			deckInfo += " | ✨ " + strings.Join(synergies, ", ")
+		}
+		b.WriteString(RenderInfo(deckInfo))
+		b.WriteString("\n\n")
+	}
+
+	// Mentors
+	if mentors := m.gameState.GetMentorSupporters(); len(mentors) > 0 {
+		var mentorInfo []string
+		for _, mentor := range mentors {
+			for trainingType, bonus := range mentor.TrainingBonus {
+				mentorInfo = append(mentorInfo, fmt.Sprintf("%s: %s +%d", mentor.Name, trainingType.String(), bonus))
+			}
+		}
+		b.WriteString(RenderInfo("🎓 " + strings.Join(mentorInfo, " | ")))
+		b.WriteString("\n\n")
+	}
+
+	// Facilities
+	var built []string
+	for _, facility := range models.AllFacilities() {
+		if level := m.gameState.FacilityLevel(facility.ID); level > 0 {
+			built = append(built, fmt.Sprintf("%s %s Lv%d", facility.Icon, facility.Name, level))
+		}
+	}
+	if len(built) > 0 {
+		b.WriteString(RenderInfo(strings.Join(built, " | ")))
+		b.WriteString("\n\n")
+	}
+
 	// Stats
 	b.WriteString(RenderHeader("Current Stats"))
 	b.WriteString("\n")
@@ -178,6 +288,13 @@ func (m TrainModel) renderCalendarView(horse *models.Horse) string {
 	b.WriteString(RenderHeader("This Week's Training"))
 	b.WriteString("\n")
 
+	var planned []models.PlanDay
+	if plan := m.gameState.GetScheduledPlan(horse.ID, m.gameState.Season.CurrentWeek); plan != nil {
+		planned = m.gameState.PreviewPlan(horse, *plan)
+		b.WriteString(RenderInfo(fmt.Sprintf("📋 Plan: %s", plan.Name)))
+		b.WriteString("\n")
+	}
+
 	days := []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}
 	for i, day := range days {
 		cursor := " "
@@ -186,6 +303,9 @@ func (m TrainModel) renderCalendarView(horse *models.Horse) string {
 		}
 
 		status := m.getDayStatus(i)
+		if status == "Available" && i < len(planned) {
+			status = fmt.Sprintf("Available (plan: %s)", planned[i].String())
+		}
 		dayInfo := fmt.Sprintf("%s %s - %s", cursor, day, status)
 
 		if m.selectedDay == i {
@@ -198,10 +318,23 @@ func (m TrainModel) renderCalendarView(horse *models.Horse) string {
 
 	b.WriteString("\n")
 
+	if m.message != "" {
+		if m.isError {
+			b.WriteString(RenderError(m.message))
+		} else {
+			b.WriteString(RenderSuccess(m.message))
+		}
+		b.WriteString("\n")
+	}
+
 	// Help text
 	if m.isWeekComplete() {
 		b.WriteString(RenderSuccess("Week completed!"))
 		b.WriteString("\n")
+		if pending := m.pendingStableHorses(); len(pending) > 0 {
+			b.WriteString(RenderWarning(fmt.Sprintf("Unscheduled days for %s will be rest days", strings.Join(pending, ", "))))
+			b.WriteString("\n")
+		}
 		b.WriteString(RenderHelp("Press 'n' to advance to next week, ESC/q to go back"))
 	} else if m.canTrainToday() {
 		helpText := "Enter to train, 'r' to rest, ↑/↓ to navigate, ESC/q to go back"
@@ -209,10 +342,19 @@ func (m TrainModel) renderCalendarView(horse *models.Horse) string {
 			helpText = "Enter to train, 'r' to rest, 'D' to dope ($5000), ↑/↓ to navigate, ESC/q to go back"
 		}
 		b.WriteString(RenderHelp(helpText))
+		b.WriteString("\n")
+		planHelp := "'p' for plans, 's' to save this week as a plan, 'v' to swap deck, 'f' for facilities"
+		if planned != nil {
+			planHelp = "'a' to follow the plan, " + planHelp
+		}
+		if m.gameState.CanMeetAndGreet(horse) == nil {
+			planHelp += ", 'm' for a meet-and-greet"
+		}
+		b.WriteString(RenderHelp(planHelp))
 	} else {
-		helpText := "↑/↓ to navigate, ESC/q to go back"
+		helpText := "↑/↓ to navigate, 'f' for facilities, ESC/q to go back"
 		if horse.AreAllStatsMaxed() {
-			helpText = "'D' to dope ($5000), ↑/↓ to navigate, ESC/q to go back"
+			helpText = "'D' to dope ($5000), ↑/↓ to navigate, 'f' for facilities, ESC/q to go back"
 		}
 		b.WriteString(RenderHelp(helpText))
 	}
@@ -237,8 +379,12 @@ func (m TrainModel) renderTypeSelectionView(horse *models.Horse) string {
 		}
 
 		current, max := m.getStatValues(horse, trainingType)
-		typeInfo := fmt.Sprintf("%s %s Training (Current: %d/%d)",
-			cursor, trainingType.String(), current, max)
+		preview := horse.PreviewTraining(trainingType, m.gameState.GetTrainingSupporters(), m.gameState.FacilityBonus(trainingType))
+		typeInfo := fmt.Sprintf("%s %s Training (Current: %d/%d, Expected: +%d, Fatigue: +%d)",
+			cursor, trainingType.String(), current, max, preview.Gain, preview.Fatigue)
+		if preview.Friendship > 1.0 {
+			typeInfo += " 🤝"
+		}
 
 		if m.selectedType == trainingType {
 			b.WriteString(selectedMenuItemStyle.Render(typeInfo))
@@ -269,11 +415,19 @@ func (m TrainModel) renderConfirmView(horse *models.Horse) string {
 	confirmInfo += fmt.Sprintf("Current Morale: %d/100\n", horse.Morale)
 
 	current, max := m.getStatValues(horse, m.selectedType)
-	confirmInfo += fmt.Sprintf("Current %s: %d/%d", m.selectedType.String(), current, max)
+	confirmInfo += fmt.Sprintf("Current %s: %d/%d", m.selectedType.Stat().String(), current, max)
 
 	b.WriteString(cardStyle.Render(confirmInfo))
 	b.WriteString("\n\n")
 
+	facilities := m.gameState.FacilityBonus(m.selectedType)
+	preview := horse.PreviewTraining(m.selectedType, m.gameState.GetTrainingSupporters(), facilities)
+	b.WriteString(RenderCard(renderTrainingPreview(preview, m.selectedType), false))
+	b.WriteString("\n\n")
+
+	b.WriteString(RenderInfo(fmt.Sprintf("Injury risk: %.1f%%", horse.InjuryChance(m.selectedType, facilities)*100)))
+	b.WriteString("\n\n")
+
 	if horse.Fatigue >= 80 {
 		b.WriteString(RenderWarning("Warning: Horse is very fatigued! Training may not be effective."))
 		b.WriteString("\n")
@@ -286,6 +440,40 @@ func (m TrainModel) renderConfirmView(horse *models.Horse) string {
 	return lipgloss.NewStyle().Padding(1, 2).Render(b.String())
 }
 
+// renderTrainingPreview shows the expected gain of a session and the modifiers behind it
+func renderTrainingPreview(preview models.TrainingPreview, trainingType models.TrainingType) string {
+	var b strings.Builder
+
+	b.WriteString(fmt.Sprintf("Expected gain: +%d %s | Fatigue: +%d\n", preview.Gain, trainingType.Stat().String(), preview.Fatigue))
+	b.WriteString(fmt.Sprintf("  Base: %d", preview.BaseGain))
+	var bonuses []string
+	if preview.SupporterBonus > 0 {
+		bonuses = append(bonuses, fmt.Sprintf("+%d from supporters", preview.SupporterBonus))
+	}
+	if preview.SynergyBonus > 0 {
+		bonuses = append(bonuses, fmt.Sprintf("+%d of it from deck synergies", preview.SynergyBonus))
+	}
+	if preview.FacilityBonus > 0 {
+		bonuses = append(bonuses, fmt.Sprintf("+%d from facilities", preview.FacilityBonus))
+	}
+	if len(bonuses) > 0 {
+		b.WriteString(fmt.Sprintf(" (incl. %s)", strings.Join(bonuses, ", ")))
+	}
+	b.WriteString("\n")
+	b.WriteString(fmt.Sprintf("  Morale x%.2f | Breed x%.2f | Age x%.2f\n", preview.Morale, preview.Breed, preview.Age))
+	b.WriteString(fmt.Sprintf("  Repetition x%.2f | Near cap x%.2f", preview.Repetition, preview.CapFalloff))
+	if preview.Friendship > 1.0 {
+		b.WriteString(fmt.Sprintf("\n  🤝 Friendship training x%.2f", preview.Friendship))
+	}
+
+	if preview.Repetition < 1.0 {
+		b.WriteString("\n")
+		b.WriteString(RenderWarning("Repeating the same training gives less - mix it up!"))
+	}
+
+	return b.String()
+}
+
 func (m TrainModel) renderResultView(horse *models.Horse) string {
 	var b strings.Builder
 
@@ -297,7 +485,23 @@ func (m TrainModel) renderResultView(horse *models.Horse) string {
 		if m.lastResult.StatGain > 0 {
 			b.WriteString("\n")
 			b.WriteString(RenderSuccess(fmt.Sprintf("+%d %s gained!",
-				m.lastResult.StatGain, m.selectedType.String())))
+				m.lastResult.StatGain, m.selectedType.Stat().String())))
+		}
+		for _, levelUp := range m.lastResult.BondLevelUps {
+			b.WriteString("\n")
+			b.WriteString(RenderSuccess(fmt.Sprintf("💞 Bond up! %s", levelUp)))
+		}
+		if m.lastResult.Injured {
+			b.WriteString("\n")
+			b.WriteString(RenderWarning("🩹 Injured: stats, fatigue and morale took a hit. Rest up before training hard again."))
+		}
+		if m.lastResult.LearnedSkill != "" {
+			b.WriteString("\n")
+			b.WriteString(RenderSuccess(fmt.Sprintf("✨ New skill learned: %s!", m.lastResult.LearnedSkill)))
+		}
+		if m.lastResult.SkillHint != "" {
+			b.WriteString("\n")
+			b.WriteString(RenderSuccess(fmt.Sprintf("💡 Skill hint: %s", m.lastResult.SkillHint)))
 		}
 	} else {
 		b.WriteString(RenderError(m.lastResult.Message))
@@ -335,11 +539,14 @@ func (m TrainModel) renderStatsCard(horse *models.Horse) string {
 }
 
 func (m TrainModel) getDayStatus(day int) string {
-	currentDays := m.gameState.Season.GetCurrentTrainingDays()
+	currentDays := m.gameState.Season.GetHorseTrainingDays(m.gameState.PlayerHorse.ID)
 
 	for _, td := range currentDays {
 		if td.Day == day {
 			if td.IsCompleted {
+				if td.Activity != "" {
+					return td.Activity + " ✓"
+				}
 				if td.IsRest {
 					return "Rest ✓"
 				}
@@ -352,7 +559,7 @@ func (m TrainModel) getDayStatus(day int) string {
 }
 
 func (m TrainModel) canTrainToday() bool {
-	currentDays := m.gameState.Season.GetCurrentTrainingDays()
+	currentDays := m.gameState.Season.GetHorseTrainingDays(m.gameState.PlayerHorse.ID)
 
 	for _, td := range currentDays {
 		if td.Day == m.selectedDay && td.IsCompleted {
@@ -364,28 +571,36 @@ func (m TrainModel) canTrainToday() bool {
 }
 
 func (m TrainModel) isWeekComplete() bool {
-	currentDays := m.gameState.Season.GetCurrentTrainingDays()
-	completedDays := 0
+	return m.gameState.IsWeekCompleteFor(m.gameState.PlayerHorse.ID)
+}
 
-	for _, td := range currentDays {
-		if td.IsCompleted {
-			completedDays++
+// pendingStableHorses returns the names of other stable horses that haven't finished this week
+func (m TrainModel) pendingStableHorses() []string {
+	var pending []string
+	week := m.gameState.Season.CurrentWeek
+	for _, horse := range m.gameState.Stable {
+		if horse.ID == m.gameState.PlayerHorse.ID || m.gameState.IsWeekCompleteFor(horse.ID) {
+			continue
+		}
+		// Horses with a plan this week follow it when the week ends
+		if m.gameState.GetScheduledPlan(horse.ID, week) == nil {
+			pending = append(pending, horse.Name)
 		}
 	}
-
-	return completedDays >= 6
+	return pending
 }
 
 func (m TrainModel) performTraining() (TrainModel, tea.Cmd) {
 	horse := m.gameState.PlayerHorse
-	// Get only active supporters (max 4)
-	supporters := m.gameState.GetActiveSupporters()
+	// Active supporters (max 4) plus any retired training mentors
+	supporters := m.gameState.GetTrainingSupporters()
 
-	result := horse.Train(m.selectedType, supporters)
+	result := m.gameState.TrainHorse(horse, m.selectedType, supporters)
 	m.lastResult = &result
 
 	// Add training day to season
 	trainingDay := models.TrainingDay{
+		HorseID:      horse.ID,
 		Week:         m.gameState.Season.CurrentWeek,
 		Day:          m.selectedDay,
 		TrainingType: m.selectedType,
@@ -396,16 +611,76 @@ func (m TrainModel) performTraining() (TrainModel, tea.Cmd) {
 
 	m.gameState.Season.AddTrainingDay(trainingDay)
 	m.mode = ViewingTrainingResult
+	if result.Event != nil && result.Event.HasChoices() {
+		m.mode = ChoosingEvent
+		m.eventCursor = 0
+	}
+
+	return m, nil
+}
+
+// updateEventChoice handles picking an option for a training event
+func (m TrainModel) updateEventChoice(msg tea.KeyMsg) (TrainModel, tea.Cmd) {
+	event := m.lastResult.Event
+
+	switch msg.String() {
+	case "up", "k":
+		if m.eventCursor > 0 {
+			m.eventCursor--
+		}
+	case "down", "j":
+		if m.eventCursor < len(event.Choices)-1 {
+			m.eventCursor++
+		}
+	case "enter", " ":
+		horse := m.gameState.PlayerHorse
+		choice, err := m.gameState.ResolvePendingEvent(m.eventCursor)
+		if err != nil {
+			return m, nil
+		}
+
+		// The event's description already closes the session's message
+		m.lastResult.Message += fmt.Sprintf(" You chose: %s", choice.Text)
+		if effects := describeEffects(choice.Effects); effects != "" {
+			m.lastResult.Message += " (" + effects + ")"
+		}
+		if choice.Hint != "" {
+			m.lastResult.SkillHint = horse.SkillHintStatus(choice.Hint)
+		}
+		m.mode = ViewingTrainingResult
+	}
 
 	return m, nil
 }
 
+func (m TrainModel) renderEventView(horse *models.Horse) string {
+	var b strings.Builder
+
+	b.WriteString(RenderTitle("Training Event"))
+	b.WriteString("\n\n")
+
+	if m.lastResult.StatGain > 0 {
+		b.WriteString(RenderSuccess(fmt.Sprintf("+%d %s gained!", m.lastResult.StatGain, m.selectedType.Stat().String())))
+		b.WriteString("\n\n")
+	}
+
+	b.WriteString(renderEventChoices(m.lastResult.Event, m.eventCursor))
+	b.WriteString("\n\n")
+
+	b.WriteString(RenderCard(m.renderStatsCard(horse), false))
+	b.WriteString("\n\n")
+	b.WriteString(RenderHelp("↑/↓ to choose, Enter to decide"))
+
+	return lipgloss.NewStyle().Padding(1, 2).Render(b.String())
+}
+
 func (m TrainModel) performRest() (TrainModel, tea.Cmd) {
 	horse := m.gameState.PlayerHorse
 	horse.Rest()
 
 	// Add rest day to season
 	trainingDay := models.TrainingDay{
+		HorseID:     horse.ID,
 		Week:        m.gameState.Season.CurrentWeek,
 		Day:         m.selectedDay,
 		IsRest:      true,
@@ -424,8 +699,27 @@ func (m TrainModel) performRest() (TrainModel, tea.Cmd) {
 	return m, nil
 }
 
+// performMeetAndGreet spends the selected day meeting fans instead of training
+func (m TrainModel) performMeetAndGreet() (TrainModel, tea.Cmd) {
+	horse := m.gameState.PlayerHorse
+	fans, err := m.gameState.MeetAndGreet(horse, m.selectedDay)
+	if err != nil {
+		m.message = err.Error()
+		m.isError = true
+		return m, nil
+	}
+
+	m.lastResult = &models.TrainingResult{
+		Success: true,
+		Message: fmt.Sprintf("%s signed autographs all day! +%d fans, morale up", horse.Name, fans),
+	}
+	m.mode = ViewingTrainingResult
+
+	return m, nil
+}
+
 func (m TrainModel) getStatValues(horse *models.Horse, trainingType models.TrainingType) (int, int) {
-	switch trainingType {
+	switch trainingType.Stat() {
 	case models.StaminaTraining:
 		return horse.Stamina, horse.MaxStamina
 	case models.SpeedTraining:
@@ -440,13 +734,11 @@ func (m TrainModel) getStatValues(horse *models.Horse, trainingType models.Train
 }
 
 func (m TrainModel) performDoping() (TrainModel, tea.Cmd) {
-	horse := m.gameState.PlayerHorse
-
-	if !horse.Dope() {
+	if err := m.gameState.DopePlayerHorse(); err != nil {
 		// Not enough money
 		result := &models.TrainingResult{
 			Success: false,
-			Message: "Not enough money for doping! You need $5000.",
+			Message: fmt.Sprintf("Not enough money for doping! You need $%d.", models.DopeCost),
 		}
 		m.lastResult = result
 		m.mode = ViewingTrainingResult
@@ -464,4 +756,302 @@ func (m TrainModel) performDoping() (TrainModel, tea.Cmd) {
 	return m, nil
 }
 
+// updatePlanChoice handles picking a plan template and the weeks to apply it to
+func (m TrainModel) updatePlanChoice(msg tea.KeyMsg) (TrainModel, tea.Cmd) {
+	plans := m.gameState.AllTrainingPlans()
+	season := m.gameState.Season
+
+	switch msg.String() {
+	case "ctrl+c", "q", "esc":
+		m.mode = SelectingDay
+	case "up", "k":
+		if m.planCursor > 0 {
+			m.planCursor--
+		}
+	case "down", "j":
+		if m.planCursor < len(plans)-1 {
+			m.planCursor++
+		}
+	case "left", "h":
+		if m.planThrough > season.CurrentWeek {
+			m.planThrough--
+		}
+	case "right", "l":
+		if m.planThrough < season.MaxWeeks {
+			m.planThrough++
+		}
+	case "x":
+		plan := plans[m.planCursor]
+		if err := m.gameState.DeleteTrainingPlan(plan.Name); err != nil {
+			m.message = err.Error()
+			m.isError = true
+			return m, nil
+		}
+		m.message = fmt.Sprintf("Deleted plan %s", plan.Name)
+		m.isError = false
+		m.planCursor = min(m.planCursor, len(plans)-2)
+	case "enter", " ":
+		plan := plans[m.planCursor]
+		horse := m.gameState.PlayerHorse
+		if err := m.gameState.SchedulePlan(horse.ID, plan, season.CurrentWeek, m.planThrough); err != nil {
+			m.message = err.Error()
+			m.isError = true
+			return m, nil
+		}
+		m.message = fmt.Sprintf("%s scheduled for weeks %d-%d. Press 'a' to follow it this week.",
+			plan.Name, season.CurrentWeek, m.planThrough)
+		m.isError = false
+		m.mode = SelectingDay
+	}
+
+	return m, nil
+}
+
+// updatePlanName handles typing a name for saving this week as a plan template
+func (m TrainModel) updatePlanName(msg tea.KeyMsg) TrainModel {
+	switch msg.Type {
+	case tea.KeyEsc, tea.KeyCtrlC:
+		m.mode = SelectingDay
+	case tea.KeyBackspace:
+		if len(m.planName) > 0 {
+			runes := []rune(m.planName)
+			m.planName = string(runes[:len(runes)-1])
+		}
+	case tea.KeyEnter:
+		plan := m.gameState.PlanFromCurrentWeek(m.gameState.PlayerHorse.ID, m.planName)
+		if err := m.gameState.SaveTrainingPlan(plan); err != nil {
+			m.message = fmt.Sprintf("Cannot save plan: %s", err.Error())
+			m.isError = true
+		} else {
+			m.message = fmt.Sprintf("Saved plan %s: %s", plan.Name, plan.Summary())
+			m.isError = false
+		}
+		m.mode = SelectingDay
+	case tea.KeySpace, tea.KeyRunes:
+		if len([]rune(m.planName)) < 24 {
+			m.planName += string(msg.Runes)
+		}
+	}
+
+	return m
+}
+
+// followPlan runs the rest of this week as the plan says, stopping for event decisions
+func (m TrainModel) followPlan(plan models.TrainingPlan) (TrainModel, tea.Cmd) {
+	horse := m.gameState.PlayerHorse
+	days, event := m.gameState.FollowPlan(horse, plan)
+	m.message = ""
+
+	if event != nil {
+		last := days[len(days)-1]
+		m.lastResult = last.Result
+		m.selectedDay = last.Day
+		m.selectedType = last.TrainingType
+		m.mode = ChoosingEvent
+		m.eventCursor = 0
+		return m, nil
+	}
+
+	gains := make(map[models.TrainingType]int)
+	trained, rested, injuries := 0, 0, 0
+	var bondUps []string
+	for _, day := range days {
+		if day.IsRest {
+			rested++
+			continue
+		}
+		trained++
+		if day.Result != nil {
+			gains[day.TrainingType.Stat()] += day.Result.StatGain
+			if day.Result.Injured {
+				injuries++
+			}
+			bondUps = append(bondUps, day.Result.BondLevelUps...)
+		}
+	}
+
+	message := fmt.Sprintf("Followed the %s plan: %d training, %d rest days.", plan.Name, trained, rested)
+	var parts []string
+	for _, trainingType := range m.trainingTypes {
+		if gains[trainingType] > 0 {
+			parts = append(parts, fmt.Sprintf("+%d %s", gains[trainingType], trainingType.String()))
+		}
+	}
+	if len(parts) > 0 {
+		message += " " + strings.Join(parts, ", ")
+	}
+	if injuries > 0 {
+		message += fmt.Sprintf(" 🩹 %s was injured during the week.", horse.Name)
+	}
+	if len(bondUps) > 0 {
+		message += " 💞 Bond up! " + strings.Join(bondUps, ", ")
+	}
+
+	m.lastResult = &models.TrainingResult{Success: true, Message: message}
+	m.mode = ViewingTrainingResult
+	return m, nil
+}
+
+func (m TrainModel) renderPlanView(horse *models.Horse) string {
+	var b strings.Builder
+
+	b.WriteString(RenderTitle("📋 Training Plans"))
+	b.WriteString("\n\n")
+
+	season := m.gameState.Season
+	b.WriteString(cardStyle.Render(fmt.Sprintf("%s | Fatigue: %d/100 | Apply to weeks %d-%d",
+		horse.Name, horse.Fatigue, season.CurrentWeek, m.planThrough)))
+	b.WriteString("\n\n")
+
+	plans := m.gameState.AllTrainingPlans()
+	for i, plan := range plans {
+		cursor := " "
+		if m.planCursor == i {
+			cursor = ">"
+		}
+		info := fmt.Sprintf("%s %s", cursor, plan.Name)
+		if !plan.BuiltIn {
+			info += " (saved)"
+		}
+		info += "\n   " + plan.Summary()
+
+		if m.planCursor == i {
+			preview := m.gameState.PreviewPlan(horse, plan)
+			var adjusted []string
+			for day, planned := range preview {
+				if planned.Rest && !plan.Days[day].Rest {
+					adjusted = append(adjusted, []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}[day])
+				}
+			}
+			if len(adjusted) > 0 {
+				info += fmt.Sprintf("\n   Auto-rest this week on %s to keep fatigue under %d",
+					strings.Join(adjusted, ", "), models.FatigueCutoff)
+			}
+		}
+
+		b.WriteString(RenderCard(info, m.planCursor == i))
+		b.WriteString("\n")
+	}
+
+	if m.message != "" {
+		b.WriteString("\n")
+		if m.isError {
+			b.WriteString(RenderError(m.message))
+		} else {
+			b.WriteString(RenderSuccess(m.message))
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(RenderHelp("↑/↓ to choose, ←/→ to change last week, Enter to schedule, 'x' to delete a saved plan, ESC to go back"))
+
+	return lipgloss.NewStyle().Padding(1, 2).Render(b.String())
+}
+
+func (m TrainModel) renderPlanNameView() string {
+	var b strings.Builder
+
+	b.WriteString(RenderTitle("💾 Save Plan"))
+	b.WriteString("\n\n")
+
+	plan := m.gameState.PlanFromCurrentWeek(m.gameState.PlayerHorse.ID, m.planName)
+	b.WriteString(cardStyle.Render(fmt.Sprintf("This week: %s\nUnused days are saved as rest.", plan.Summary())))
+	b.WriteString("\n\n")
+	b.WriteString(fmt.Sprintf("Plan name: %s█", m.planName))
+	b.WriteString("\n\n")
+	b.WriteString(RenderHelp("Type a name, Enter to save, ESC to cancel"))
+
+	return lipgloss.NewStyle().Padding(1, 2).Render(b.String())
+}
+
+// selectedTypeIndex returns the position of the selected type in the training type list
+func (m TrainModel) selectedTypeIndex() int {
+	for i, trainingType := range m.trainingTypes {
+		if trainingType == m.selectedType {
+			return i
+		}
+	}
+	return 0
+}
+
+// updateFacilities handles building and upgrading stable facilities
+func (m TrainModel) updateFacilities(msg tea.KeyMsg) TrainModel {
+	facilities := models.AllFacilities()
+
+	switch msg.String() {
+	case "ctrl+c", "q", "esc":
+		m.mode = SelectingDay
+		m.message = ""
+	case "up", "k":
+		if m.facilityCursor > 0 {
+			m.facilityCursor--
+		}
+	case "down", "j":
+		if m.facilityCursor < len(facilities)-1 {
+			m.facilityCursor++
+		}
+	case "enter", " ":
+		facility := facilities[m.facilityCursor]
+		if err := m.gameState.UpgradeFacility(facility.ID); err != nil {
+			m.message = fmt.Sprintf("Cannot upgrade: %s", err.Error())
+			m.isError = true
+			return m
+		}
+		m.message = fmt.Sprintf("%s %s is now level %d!", facility.Icon, facility.Name, m.gameState.FacilityLevel(facility.ID))
+		m.isError = false
+		m.trainingTypes = m.gameState.AvailableTrainingTypes()
+	}
+
+	return m
+}
+
+func (m TrainModel) renderFacilitiesView() string {
+	var b strings.Builder
+
+	b.WriteString(RenderTitle("🏗️ Training Facilities"))
+	b.WriteString("\n\n")
+
+	b.WriteString(cardStyle.Render(fmt.Sprintf("Treasury: $%d", m.gameState.Treasury)))
+	b.WriteString("\n\n")
+
+	for i, facility := range models.AllFacilities() {
+		cursor := " "
+		if m.facilityCursor == i {
+			cursor = ">"
+		}
+
+		level := m.gameState.FacilityLevel(facility.ID)
+		info := fmt.Sprintf("%s %s %s  Level %d/%d", cursor, facility.Icon, facility.Name, level, facility.MaxLevel)
+		if level < facility.MaxLevel {
+			action := "Upgrade"
+			if level == 0 {
+				action = "Build"
+			}
+			info += fmt.Sprintf("  (%s: $%d)", action, facility.UpgradeCost(level))
+		} else {
+			info += "  (Max)"
+		}
+		info += "\n   " + facility.Description
+
+		b.WriteString(RenderCard(info, m.facilityCursor == i))
+		b.WriteString("\n")
+	}
+
+	if m.message != "" {
+		b.WriteString("\n")
+		if m.isError {
+			b.WriteString(RenderError(m.message))
+		} else {
+			b.WriteString(RenderSuccess(m.message))
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(RenderHelp("↑/↓ to choose, Enter to build or upgrade, ESC to go back"))
+
+	return lipgloss.NewStyle().Padding(1, 2).Render(b.String())
+}
+
 type WeekCompleteMsg struct{}